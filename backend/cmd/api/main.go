@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	// Embedded time zone database, user time zones must load on images without tzdata
+	_ "time/tzdata"
 
 	config "github.com/english-coach/backend/configs"
 	"github.com/english-coach/backend/internal/app/bootstrap"
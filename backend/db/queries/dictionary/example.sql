@@ -0,0 +1,5 @@
+-- name: FindExamplesBySenseIDs :many
+SELECT id, source_sense_id, language_id, content, audio_url, source
+FROM examples
+WHERE source_sense_id = ANY($1::bigint[])
+ORDER BY source_sense_id, id;
@@ -0,0 +1,5 @@
+-- name: FindPronunciationsByWordIDs :many
+SELECT id, word_id, dialect, ipa, phonetic, audio_url
+FROM pronunciations
+WHERE word_id = ANY($1::bigint[])
+ORDER BY word_id, id;
@@ -80,6 +80,53 @@ WHERE s.level_id = sqlc.arg('level_id')
 ORDER BY w.frequency_rank NULLS LAST, w.id
 LIMIT sqlc.arg('limit');
 
+-- name: FindWordsAcrossLevelsAndLanguages :many
+-- Words of every level in a language pair, optionally filtered by topics.
+-- Words are interleaved by level (the most frequent word of each level first)
+-- so that a limited result spans all levels.
+WITH word_levels AS (
+    -- A word takes the lowest level among its senses that can be translated
+    SELECT w.id, MIN(s.level_id) AS level_id
+    FROM words w
+    INNER JOIN senses s ON w.id = s.word_id
+    WHERE w.language_id = sqlc.arg('source_language_id')
+      AND s.level_id IS NOT NULL
+      AND (
+        sqlc.arg('topic_ids')::bigint[] IS NULL
+        OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+        OR EXISTS (
+          SELECT 1
+          FROM word_topics wt
+          WHERE wt.word_id = w.id
+            AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+        )
+      )
+      AND EXISTS (
+          SELECT 1
+          FROM sense_translations st
+          INNER JOIN words tw ON st.target_word_id = tw.id
+          WHERE st.source_sense_id = s.id
+            AND tw.language_id = sqlc.arg('target_language_id')
+      )
+    GROUP BY w.id
+),
+ranked AS (
+    SELECT wl.id,
+           ROW_NUMBER() OVER (
+               PARTITION BY wl.level_id
+               ORDER BY w.frequency_rank NULLS LAST, w.id
+           ) AS level_rank
+    FROM word_levels wl
+    INNER JOIN words w ON w.id = wl.id
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at
+FROM ranked r
+INNER JOIN words w ON w.id = r.id
+ORDER BY r.level_rank, w.frequency_rank NULLS LAST, w.id
+LIMIT sqlc.arg('limit');
+
 -- name: FindTranslationsForWord :many
 WITH ranked AS (
   SELECT
@@ -102,36 +149,515 @@ ORDER BY r.ord_priority ASC NULLS LAST,
          tw.id
 LIMIT sqlc.arg('limit');
 
+-- name: FindDistractorWords :many
+-- Candidate wrong answers for a word: words of the same language ranked by a shared part of
+-- speech and a shared topic, then by the closest frequency rank. Only a pool of words found
+-- through indexes is ranked: the closest frequency ranks on both sides of the word, unranked
+-- words and words sharing a topic with it, each taken in a fixed order so that seeded sessions
+-- get the same pool. The word itself, words with the same lemma, synonyms
+-- of the word, the excluded words and words translated into one of the excluded translations
+-- are left out.
+WITH target AS (
+  SELECT id, language_id, lemma, frequency_rank
+  FROM words
+  WHERE id = sqlc.arg('word_id')
+),
+pool AS (
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank >= t.frequency_rank
+    ORDER BY w.frequency_rank, w.id
+    LIMIT sqlc.arg('limit')::int * 4
+  )
+  UNION
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank < t.frequency_rank
+    ORDER BY w.frequency_rank DESC, w.id
+    LIMIT sqlc.arg('limit')::int * 4
+  )
+  UNION
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank IS NULL
+    ORDER BY w.id
+    LIMIT sqlc.arg('limit')::int * 4
+  )
+  UNION
+  (
+    SELECT wt.word_id
+    FROM word_topics twt
+    JOIN word_topics wt ON wt.topic_id = twt.topic_id
+    WHERE twt.word_id = sqlc.arg('word_id')
+    ORDER BY wt.word_id
+    LIMIT sqlc.arg('limit')::int * 4
+  )
+),
+synonyms AS (
+  SELECT wr.to_word_id AS word_id
+  FROM word_relations wr
+  WHERE wr.from_word_id = sqlc.arg('word_id') AND wr.relation_type = 'synonym'
+  UNION
+  SELECT wr.from_word_id
+  FROM word_relations wr
+  WHERE wr.to_word_id = sqlc.arg('word_id') AND wr.relation_type = 'synonym'
+),
+candidates AS (
+  SELECT
+    w.id,
+    EXISTS (
+      SELECT 1
+      FROM senses s
+      JOIN senses ts ON ts.part_of_speech_id = s.part_of_speech_id
+      WHERE s.word_id = w.id AND ts.word_id = t.id
+    ) AS same_pos,
+    EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      JOIN word_topics twt ON twt.topic_id = wt.topic_id
+      WHERE wt.word_id = w.id AND twt.word_id = t.id
+    ) AS same_topic,
+    ABS(COALESCE(w.frequency_rank, 1000000) - COALESCE(t.frequency_rank, 1000000)) AS frequency_gap
+  FROM pool p
+  JOIN words w ON w.id = p.id
+  CROSS JOIN target t
+  WHERE w.language_id = t.language_id
+    AND w.id <> t.id
+    AND LOWER(w.lemma) <> LOWER(t.lemma)
+    AND NOT (w.id = ANY(sqlc.arg('excluded_ids')::bigint[]))
+    AND w.id NOT IN (SELECT word_id FROM synonyms)
+    AND NOT EXISTS (
+      SELECT 1
+      FROM senses s
+      JOIN sense_translations st ON st.source_sense_id = s.id
+      WHERE s.word_id = w.id
+        AND st.target_word_id = ANY(sqlc.arg('excluded_translation_ids')::bigint[])
+    )
+)
+SELECT
+  w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+  w.romanization, w.script_code, w.frequency_rank,
+  w.note, w.created_at, w.updated_at
+FROM candidates c
+JOIN words w ON w.id = c.id
+ORDER BY (c.same_pos::int + c.same_topic::int) DESC,
+         c.frequency_gap,
+         w.id
+LIMIT sqlc.arg('limit')::int;
+
+-- name: FindTranslationsForSense :many
+SELECT
+  tw.id, tw.language_id, tw.lemma, tw.lemma_normalized, tw.search_key,
+  tw.romanization, tw.script_code, tw.frequency_rank,
+  tw.note, tw.created_at, tw.updated_at
+FROM sense_translations st
+JOIN words tw ON tw.id = st.target_word_id
+WHERE st.source_sense_id = sqlc.arg('source_sense_id')
+  AND tw.language_id = sqlc.arg('target_language_id')
+ORDER BY st.priority ASC NULLS LAST,
+         tw.frequency_rank NULLS LAST,
+         tw.id
+LIMIT sqlc.arg('limit');
+
 -- name: SearchWords :many
+-- Words whose lemma, normalized form or search key contain the query, or are similar enough to it
+-- to catch misspellings (trigram similarity above pg_trgm.similarity_threshold, 0.3 by default).
+-- Every condition is served by the trigram indexes. Exact matches come first, then prefix matches,
+-- then substring matches, then similar words; within each group words are ranked by similarity
+-- boosted for frequent words. The optional filters narrow the words down by topics (any of them),
+-- level and part of speech (of the same sense), audio pronunciation and script.
+WITH matches AS (
+  SELECT w.id,
+         CASE
+           WHEN LOWER(w.lemma) = LOWER(sqlc.arg('query')::text)
+             OR w.lemma_normalized = LOWER(sqlc.arg('query')::text)
+             OR w.search_key = LOWER(sqlc.arg('query')::text) THEN 1
+           WHEN w.lemma ILIKE sqlc.arg('prefix_pattern')
+             OR w.lemma_normalized ILIKE sqlc.arg('prefix_pattern')
+             OR w.search_key ILIKE sqlc.arg('prefix_pattern') THEN 2
+           WHEN w.lemma ILIKE sqlc.arg('search_pattern')
+             OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
+             OR w.search_key ILIKE sqlc.arg('search_pattern') THEN 3
+           ELSE 4
+         END AS match_rank,
+         GREATEST(
+           similarity(w.lemma, sqlc.arg('query')::text),
+           similarity(COALESCE(w.lemma_normalized, ''), sqlc.arg('query')::text),
+           similarity(COALESCE(w.search_key, ''), sqlc.arg('query')::text)
+         ) + 0.25 / (1 + LOG(COALESCE(w.frequency_rank, 1000000)::float8 + 1)) AS score
+  FROM words w
+  WHERE w.language_id = sqlc.arg('language_id')
+    AND (
+      w.lemma ILIKE sqlc.arg('search_pattern')
+      OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
+      OR w.search_key ILIKE sqlc.arg('search_pattern')
+      OR w.lemma % sqlc.arg('query')::text
+      OR w.lemma_normalized % sqlc.arg('query')::text
+      OR w.search_key % sqlc.arg('query')::text
+    )
+    AND (
+      sqlc.arg('topic_ids')::bigint[] IS NULL
+      OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+      OR EXISTS (
+        SELECT 1
+        FROM word_topics wt
+        WHERE wt.word_id = w.id
+          AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+      )
+    )
+    AND (
+      -- Level and part of speech must be those of the same sense
+      (sqlc.narg('level_id')::bigint IS NULL AND sqlc.narg('part_of_speech_id')::smallint IS NULL)
+      OR EXISTS (
+        SELECT 1
+        FROM senses s
+        WHERE s.word_id = w.id
+          AND (sqlc.narg('level_id')::bigint IS NULL OR s.level_id = sqlc.narg('level_id'))
+          AND (sqlc.narg('part_of_speech_id')::smallint IS NULL OR s.part_of_speech_id = sqlc.narg('part_of_speech_id'))
+      )
+    )
+    AND (
+      sqlc.narg('has_audio')::boolean IS NULL
+      OR sqlc.narg('has_audio')::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND (sqlc.narg('script_code')::text IS NULL OR w.script_code = sqlc.narg('script_code'))
+)
 SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
        w.romanization, w.script_code, w.frequency_rank,
        w.note, w.created_at, w.updated_at
+FROM matches m
+INNER JOIN words w ON w.id = m.id
+ORDER BY m.match_rank, m.score DESC, w.frequency_rank NULLS LAST, w.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSearchWords :one
+SELECT COUNT(*)
 FROM words w
 WHERE w.language_id = sqlc.arg('language_id')
   AND (
     w.lemma ILIKE sqlc.arg('search_pattern')
     OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
     OR w.search_key ILIKE sqlc.arg('search_pattern')
+    OR w.lemma % sqlc.arg('query')::text
+    OR w.lemma_normalized % sqlc.arg('query')::text
+    OR w.search_key % sqlc.arg('query')::text
+  )
+  AND (
+    sqlc.arg('topic_ids')::bigint[] IS NULL
+    OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+    )
+  )
+  AND (
+    -- Level and part of speech must be those of the same sense
+    (sqlc.narg('level_id')::bigint IS NULL AND sqlc.narg('part_of_speech_id')::smallint IS NULL)
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND (sqlc.narg('level_id')::bigint IS NULL OR s.level_id = sqlc.narg('level_id'))
+        AND (sqlc.narg('part_of_speech_id')::smallint IS NULL OR s.part_of_speech_id = sqlc.narg('part_of_speech_id'))
+    )
+  )
+  AND (
+    sqlc.narg('has_audio')::boolean IS NULL
+    OR sqlc.narg('has_audio')::boolean = EXISTS (
+      SELECT 1
+      FROM pronunciations p
+      WHERE p.word_id = w.id
+        AND p.audio_url IS NOT NULL
+        AND p.audio_url <> ''
+    )
+  )
+  AND (sqlc.narg('script_code')::text IS NULL OR w.script_code = sqlc.narg('script_code'));
+
+-- name: CountSearchWordsByLevel :many
+-- Facet of SearchWords: the number of matching words per level of their senses, whatever the level
+-- filter so that the other levels can be picked. A word with senses of several levels counts in each;
+-- with a part of speech filter only the senses of that part of speech count.
+WITH matches AS (
+  SELECT w.id
+  FROM words w
+  WHERE w.language_id = sqlc.arg('language_id')
+    AND (
+      w.lemma ILIKE sqlc.arg('search_pattern')
+      OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
+      OR w.search_key ILIKE sqlc.arg('search_pattern')
+      OR w.lemma % sqlc.arg('query')::text
+      OR w.lemma_normalized % sqlc.arg('query')::text
+      OR w.search_key % sqlc.arg('query')::text
+    )
+    AND (
+      sqlc.arg('topic_ids')::bigint[] IS NULL
+      OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+      OR EXISTS (
+        SELECT 1
+        FROM word_topics wt
+        WHERE wt.word_id = w.id
+          AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+      )
+    )
+    AND (
+      sqlc.narg('has_audio')::boolean IS NULL
+      OR sqlc.narg('has_audio')::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND (sqlc.narg('script_code')::text IS NULL OR w.script_code = sqlc.narg('script_code'))
+)
+SELECT l.id, l.code, l.name, COUNT(DISTINCT s.word_id) AS word_count
+FROM matches m
+INNER JOIN senses s ON s.word_id = m.id
+INNER JOIN levels l ON l.id = s.level_id
+WHERE sqlc.narg('part_of_speech_id')::smallint IS NULL OR s.part_of_speech_id = sqlc.narg('part_of_speech_id')
+GROUP BY l.id, l.code, l.name, l.difficulty_order
+ORDER BY l.difficulty_order NULLS LAST, l.id;
+
+-- name: CountSearchWordsByTopic :many
+-- Facet of SearchWords: the number of matching words per topic, whatever the topic filter so that
+-- other topics can be picked. The largest topics come first.
+WITH matches AS (
+  SELECT w.id
+  FROM words w
+  WHERE w.language_id = sqlc.arg('language_id')
+    AND (
+      w.lemma ILIKE sqlc.arg('search_pattern')
+      OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
+      OR w.search_key ILIKE sqlc.arg('search_pattern')
+      OR w.lemma % sqlc.arg('query')::text
+      OR w.lemma_normalized % sqlc.arg('query')::text
+      OR w.search_key % sqlc.arg('query')::text
+    )
+    AND (
+      -- Level and part of speech must be those of the same sense
+      (sqlc.narg('level_id')::bigint IS NULL AND sqlc.narg('part_of_speech_id')::smallint IS NULL)
+      OR EXISTS (
+        SELECT 1
+        FROM senses s
+        WHERE s.word_id = w.id
+          AND (sqlc.narg('level_id')::bigint IS NULL OR s.level_id = sqlc.narg('level_id'))
+          AND (sqlc.narg('part_of_speech_id')::smallint IS NULL OR s.part_of_speech_id = sqlc.narg('part_of_speech_id'))
+      )
+    )
+    AND (
+      sqlc.narg('has_audio')::boolean IS NULL
+      OR sqlc.narg('has_audio')::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND (sqlc.narg('script_code')::text IS NULL OR w.script_code = sqlc.narg('script_code'))
+)
+SELECT t.id, t.code, t.name, COUNT(*) AS word_count
+FROM matches m
+INNER JOIN word_topics wt ON wt.word_id = m.id
+INNER JOIN topics t ON t.id = wt.topic_id
+GROUP BY t.id, t.code, t.name
+ORDER BY word_count DESC, t.id;
+
+-- name: ReverseSearchWords :many
+-- Words of a language found by their meaning, the reverse of FindTranslationsForWord: words having
+-- a sense translated into a word of the source language whose lemma, normalized form or search key
+-- contains the query, or a sense whose definition contains it. Each word comes once with its best matching sense; exact
+-- translations rank first, then partial translations, then definitions, then senses in order.
+WITH sense_matches AS (
+  SELECT s.id AS sense_id,
+         s.word_id,
+         s.sense_order,
+         'translation'::text AS matched_by,
+         tw.lemma::text AS matched_text,
+         CASE
+           WHEN LOWER(tw.lemma) = LOWER(sqlc.arg('query')::text)
+             OR tw.lemma_normalized = LOWER(sqlc.arg('query')::text)
+             OR tw.search_key = LOWER(sqlc.arg('query')::text) THEN 1
+           ELSE 2
+         END AS match_rank,
+         st.priority
+  FROM words tw
+  INNER JOIN sense_translations st ON st.target_word_id = tw.id
+  INNER JOIN senses s ON s.id = st.source_sense_id
+  WHERE (tw.lemma ILIKE sqlc.arg('search_pattern')
+      OR tw.lemma_normalized ILIKE sqlc.arg('search_pattern')
+      OR tw.search_key ILIKE sqlc.arg('search_pattern'))
+    AND tw.language_id = sqlc.arg('source_language_id')
+  UNION ALL
+  SELECT s.id, s.word_id, s.sense_order, 'definition'::text, s.definition, 3, NULL::smallint
+  FROM senses s
+  WHERE s.definition ILIKE sqlc.arg('search_pattern')
+),
+best AS (
+  SELECT DISTINCT ON (m.word_id)
+         m.word_id, m.sense_id, m.matched_by, m.matched_text, m.match_rank
+  FROM sense_matches m
+  INNER JOIN words w ON w.id = m.word_id
+  WHERE w.language_id = sqlc.arg('language_id')
+  ORDER BY m.word_id, m.match_rank, m.sense_order, m.priority NULLS LAST
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       s.id AS sense_id, s.sense_order, s.part_of_speech_id, s.definition, s.definition_language_id,
+       b.matched_by, b.matched_text
+FROM best b
+INNER JOIN words w ON w.id = b.word_id
+INNER JOIN senses s ON s.id = b.sense_id
+ORDER BY b.match_rank, w.frequency_rank NULLS LAST, w.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountReverseSearchWords :one
+WITH matched_senses AS (
+  SELECT st.source_sense_id AS sense_id
+  FROM words tw
+  INNER JOIN sense_translations st ON st.target_word_id = tw.id
+  WHERE (tw.lemma ILIKE sqlc.arg('search_pattern')
+      OR tw.lemma_normalized ILIKE sqlc.arg('search_pattern')
+      OR tw.search_key ILIKE sqlc.arg('search_pattern'))
+    AND tw.language_id = sqlc.arg('source_language_id')
+  UNION
+  SELECT s.id
+  FROM senses s
+  WHERE s.definition ILIKE sqlc.arg('search_pattern')
+)
+SELECT COUNT(DISTINCT s.word_id)
+FROM matched_senses m
+INNER JOIN senses s ON s.id = m.sense_id
+INNER JOIN words w ON w.id = s.word_id
+WHERE w.language_id = sqlc.arg('language_id');
+
+-- name: SuggestWords :many
+-- "Did you mean" lemmas for a query matching no word: the nearest words by trigram distance over
+-- the lemma, the normalized form and the search key, each found by a GiST index scan, keeping those
+-- at least min_similarity similar to the query
+WITH candidates AS (
+  (SELECT w.id, w.lemma <-> sqlc.arg('query')::text AS distance
+   FROM words w
+   WHERE w.language_id = sqlc.arg('language_id')
+   ORDER BY w.lemma <-> sqlc.arg('query')::text
+   LIMIT sqlc.arg('limit'))
+  UNION ALL
+  (SELECT w.id, w.lemma_normalized <-> sqlc.arg('query')::text AS distance
+   FROM words w
+   WHERE w.language_id = sqlc.arg('language_id')
+   ORDER BY w.lemma_normalized <-> sqlc.arg('query')::text
+   LIMIT sqlc.arg('limit'))
+  UNION ALL
+  (SELECT w.id, w.search_key <-> sqlc.arg('query')::text AS distance
+   FROM words w
+   WHERE w.language_id = sqlc.arg('language_id')
+   ORDER BY w.search_key <-> sqlc.arg('query')::text
+   LIMIT sqlc.arg('limit'))
+)
+SELECT w.lemma
+FROM candidates c
+INNER JOIN words w ON w.id = c.id
+WHERE c.distance <= 1 - sqlc.arg('min_similarity')::float4
+GROUP BY w.lemma
+ORDER BY MIN(c.distance), MIN(w.frequency_rank) NULLS LAST, w.lemma
+LIMIT sqlc.arg('limit');
+
+-- name: FindAllWords :many
+-- Every word of every language, the source of the in-memory autocomplete index
+SELECT id, language_id, lemma, lemma_normalized, search_key,
+       romanization, script_code, frequency_rank,
+       note, created_at, updated_at
+FROM words
+ORDER BY id;
+
+-- name: FindWordIndexVersion :one
+-- Fingerprint of the words table: adding, removing or updating a word changes it
+SELECT COUNT(*) AS word_count,
+       COALESCE(MAX(id), 0)::bigint AS max_word_id,
+       COALESCE(MAX(updated_at), 'epoch'::timestamp)::timestamp AS last_updated_at
+FROM words;
+
+-- name: ListWords :many
+-- Words of a language for browsing, optionally filtered by level (of a sense) and topics (any of
+-- them), sorted by frequency or alphabetically by search key (pinyin for Chinese). Each word comes
+-- with its primary translation into the target language, if any: the translation of its first
+-- translated sense with the highest priority.
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       tr.id AS translation_id, tr.lemma AS translation_lemma, tr.romanization AS translation_romanization
+FROM words w
+LEFT JOIN LATERAL (
+  SELECT tw.id, tw.lemma, tw.romanization
+  FROM senses s
+  INNER JOIN sense_translations st ON st.source_sense_id = s.id
+  INNER JOIN words tw ON tw.id = st.target_word_id
+  WHERE s.word_id = w.id
+    AND tw.language_id = sqlc.narg('target_language_id')
+  ORDER BY s.sense_order, st.priority NULLS LAST, tw.frequency_rank NULLS LAST, tw.id
+  LIMIT 1
+) tr ON TRUE
+WHERE w.language_id = sqlc.arg('language_id')
+  AND (
+    sqlc.narg('level_id')::bigint IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND s.level_id = sqlc.narg('level_id')
+    )
   )
-ORDER BY 
-  CASE 
-    WHEN w.lemma = sqlc.arg('exact_match') THEN 1
-    WHEN w.lemma ILIKE sqlc.arg('search_pattern') THEN 2
-    WHEN w.lemma_normalized ILIKE sqlc.arg('search_pattern') THEN 3
-    WHEN w.search_key ILIKE sqlc.arg('search_pattern') THEN 4
-    ELSE 5
-  END,
-  w.frequency_rank NULLS LAST,
-  w.id
+  AND (
+    sqlc.arg('topic_ids')::bigint[] IS NULL
+    OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+    )
+  )
+ORDER BY
+  CASE WHEN sqlc.arg('sort_by_frequency')::boolean THEN w.frequency_rank END NULLS LAST,
+  COALESCE(w.search_key, w.lemma_normalized, LOWER(w.lemma)), w.lemma, w.id
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: CountSearchWords :one
-SELECT COUNT(DISTINCT w.id)
+-- name: CountListWords :one
+SELECT COUNT(*)
 FROM words w
 WHERE w.language_id = sqlc.arg('language_id')
   AND (
-    w.lemma ILIKE sqlc.arg('search_pattern')
-    OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
-    OR w.search_key ILIKE sqlc.arg('search_pattern')
+    sqlc.narg('level_id')::bigint IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND s.level_id = sqlc.narg('level_id')
+    )
+  )
+  AND (
+    sqlc.arg('topic_ids')::bigint[] IS NULL
+    OR array_length(sqlc.arg('topic_ids')::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY(sqlc.arg('topic_ids')::bigint[])
+    )
   );
-
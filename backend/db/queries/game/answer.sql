@@ -1,21 +1,56 @@
 -- name: CreateGameAnswer :one
-INSERT INTO vocab_game_question_answers (
-    question_id, session_id, user_id,
-    selected_option_id, is_correct, response_time_ms, answered_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, answered_at;
+-- Stores the answer and, if it is correct, increments the correct count of its session
+-- in the same statement. A second answer to the same question violates uq_vgqa_question_user
+-- and leaves the session untouched.
+WITH inserted AS (
+    INSERT INTO vocab_game_question_answers (
+        question_id, session_id, user_id,
+        selected_option_id, is_correct, response_time_ms,
+        answer_text, match_result, answered_at
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    RETURNING id, session_id, is_correct, answered_at
+),
+scored AS (
+    UPDATE vocab_game_sessions s
+    SET correct_questions = COALESCE(s.correct_questions, 0) + 1
+    FROM inserted i
+    WHERE s.id = i.session_id AND i.is_correct
+    RETURNING s.correct_questions
+)
+SELECT i.id, i.answered_at,
+       COALESCE(
+           (SELECT correct_questions FROM scored),
+           (SELECT s.correct_questions FROM vocab_game_sessions s WHERE s.id = i.session_id),
+           0
+       )::smallint AS correct_questions
+FROM inserted i;
 
 -- name: FindGameAnswerByQuestionID :one
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms,
+       answer_text, match_result, answered_at
 FROM vocab_game_question_answers
 WHERE question_id = $1 AND session_id = $2 AND user_id = $3
 LIMIT 1;
 
 -- name: FindGameAnswersBySessionID :many
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms,
+       answer_text, match_result, answered_at
 FROM vocab_game_question_answers
 WHERE session_id = $1 AND user_id = $2
 ORDER BY answered_at;
 
+-- name: CountGameAnswersBySessionID :one
+SELECT COUNT(*)
+FROM vocab_game_question_answers
+WHERE session_id = $1 AND user_id = $2;
+
+-- name: FindMissedWordIDsBySessionID :many
+-- Source words of the questions answered wrongly by the user in a session, once each, in question order
+SELECT q.source_word_id
+FROM vocab_game_question_answers a
+JOIN vocab_game_questions q ON q.id = a.question_id
+WHERE a.session_id = $1 AND a.user_id = $2 AND a.is_correct = FALSE
+GROUP BY q.source_word_id
+ORDER BY MIN(q.question_order);
@@ -0,0 +1,19 @@
+-- name: CreateGameDuel :one
+INSERT INTO vocab_game_duels (room_code, started_at)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: CreateGameDuelPlayer :exec
+INSERT INTO vocab_game_duel_players (duel_id, user_id, session_id)
+VALUES ($1, $2, $3);
+
+-- name: EndGameDuel :exec
+UPDATE vocab_game_duels
+SET ended_at = $2
+WHERE id = $1;
+
+-- name: UpdateGameDuelPlayerResult :exec
+UPDATE vocab_game_duel_players
+SET points = $3,
+    rank = $4
+WHERE duel_id = $1 AND user_id = $2;
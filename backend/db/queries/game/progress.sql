@@ -0,0 +1,5 @@
+-- name: FindUserShareProgress :one
+-- Whether the user lets other users watch their live progress; users without a profile do not
+SELECT COALESCE(share_progress, FALSE)::boolean AS share_progress
+FROM user_profiles
+WHERE user_id = $1;
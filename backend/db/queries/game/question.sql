@@ -2,8 +2,8 @@
 INSERT INTO vocab_game_questions (
     session_id, question_order, question_type,
     source_word_id, source_sense_id, correct_target_word_id,
-    source_language_id, target_language_id, created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    source_language_id, target_language_id, prompt_text, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING id, created_at;
 
 -- name: CreateGameQuestionOption :one
@@ -15,7 +15,7 @@ RETURNING id;
 -- name: FindGameQuestionsBySessionID :many
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, prompt_text, created_at
 FROM vocab_game_questions
 WHERE session_id = $1
 ORDER BY question_order;
@@ -23,7 +23,7 @@ ORDER BY question_order;
 -- name: FindGameQuestionByID :one
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, prompt_text, created_at
 FROM vocab_game_questions
 WHERE id = $1;
 
@@ -2,33 +2,38 @@
 INSERT INTO vocab_game_sessions (
     user_id, mode, source_language_id, target_language_id,
     topic_id, level_id, total_questions, correct_questions,
-    started_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    started_at, option_count, question_time_limit_ms, challenge_date
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING id, started_at;
 
 -- name: FindGameSessionByID :one
 SELECT id, user_id, mode, source_language_id, target_language_id,
        topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       started_at, ended_at, option_count, question_time_limit_ms, challenge_date
 FROM vocab_game_sessions
 WHERE id = $1;
 
 -- name: UpdateGameSession :exec
+-- correct_questions is only changed by CreateGameAnswer and EndGameSession
 UPDATE vocab_game_sessions
 SET total_questions = $2,
-    correct_questions = $3,
-    ended_at = $4
+    ended_at = $3
 WHERE id = $1;
 
--- name: EndGameSession :exec
-UPDATE vocab_game_sessions 
-SET ended_at = $2 
-WHERE id = $1;
+-- name: EndGameSession :execrows
+UPDATE vocab_game_sessions
+SET ended_at = $2,
+    correct_questions = (
+        SELECT COUNT(*)
+        FROM vocab_game_question_answers a
+        WHERE a.session_id = vocab_game_sessions.id AND a.is_correct = TRUE
+    )
+WHERE id = $1 AND ended_at IS NULL;
 
 -- name: FindGameSessionsByUserID :many
 SELECT id, user_id, mode, source_language_id, target_language_id,
        topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       started_at, ended_at, option_count, question_time_limit_ms, challenge_date
 FROM vocab_game_sessions
 WHERE user_id = sqlc.arg('user_id')
 ORDER BY started_at DESC
@@ -39,3 +44,37 @@ SELECT COUNT(*)
 FROM vocab_game_sessions
 WHERE user_id = sqlc.arg('user_id');
 
+-- name: FindDailyChallengeLeaderboard :many
+-- Ended daily challenge sessions of a day and language pair, ranked by score and then by
+-- the time from the start of the session to its last answer, measured by the server; ties
+-- share a rank
+WITH results AS (
+    SELECT s.id, s.user_id, s.ended_at,
+           COALESCE(s.correct_questions, 0)::smallint AS correct_questions,
+           COALESCE(s.total_questions, 0)::smallint AS total_questions,
+           (EXTRACT(EPOCH FROM COALESCE(MAX(a.answered_at), s.ended_at) - s.started_at) * 1000)::bigint AS total_response_time_ms
+    FROM vocab_game_sessions s
+    LEFT JOIN vocab_game_question_answers a ON a.session_id = s.id
+    WHERE s.challenge_date = sqlc.arg('challenge_date')
+      AND s.source_language_id = sqlc.arg('source_language_id')
+      AND s.target_language_id = sqlc.arg('target_language_id')
+      AND s.ended_at IS NOT NULL
+    GROUP BY s.id
+)
+SELECT RANK() OVER (ORDER BY r.correct_questions DESC, r.total_response_time_ms) AS rank,
+       r.id AS session_id, r.user_id,
+       COALESCE(p.display_name, u.username, '')::text AS display_name,
+       r.correct_questions, r.total_questions, r.total_response_time_ms, r.ended_at
+FROM results r
+JOIN users u ON u.id = r.user_id
+LEFT JOIN user_profiles p ON p.user_id = r.user_id
+ORDER BY rank, r.ended_at, r.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountDailyChallengeEntries :one
+SELECT COUNT(*)
+FROM vocab_game_sessions
+WHERE challenge_date = sqlc.arg('challenge_date')
+  AND source_language_id = sqlc.arg('source_language_id')
+  AND target_language_id = sqlc.arg('target_language_id')
+  AND ended_at IS NOT NULL;
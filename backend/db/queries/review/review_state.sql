@@ -0,0 +1,65 @@
+-- name: FindReviewState :one
+-- Locks the state until the end of the transaction, so that concurrent answers on a word
+-- are scheduled one after the other
+SELECT user_id, word_id, ease_factor, interval_days, repetitions, due_at
+FROM user_word_statistics
+WHERE user_id = sqlc.arg('user_id') AND word_id = sqlc.arg('word_id')
+FOR UPDATE;
+
+-- name: SaveReviewState :exec
+INSERT INTO user_word_statistics (
+    user_id, word_id, ease_factor, interval_days, repetitions, due_at
+) VALUES (
+    sqlc.arg('user_id'),
+    sqlc.arg('word_id'),
+    sqlc.arg('ease_factor'),
+    sqlc.arg('interval_days'),
+    sqlc.arg('repetitions'),
+    sqlc.arg('due_at')
+)
+ON CONFLICT (user_id, word_id) DO UPDATE
+SET ease_factor = EXCLUDED.ease_factor,
+    interval_days = EXCLUDED.interval_days,
+    repetitions = EXCLUDED.repetitions,
+    due_at = EXCLUDED.due_at;
+
+-- name: FindDueReviewsByUserID :many
+SELECT uws.word_id, w.lemma, w.language_id,
+       uws.ease_factor, uws.interval_days, uws.repetitions,
+       uws.due_at, uws.last_answered_at
+FROM user_word_statistics uws
+JOIN words w ON w.id = uws.word_id
+WHERE uws.user_id = sqlc.arg('user_id')
+  AND uws.due_at <= sqlc.arg('due_before')
+  AND (sqlc.narg('language_id')::smallint IS NULL OR w.language_id = sqlc.narg('language_id'))
+ORDER BY uws.due_at, uws.word_id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountDueReviewsByUserID :one
+SELECT COUNT(*)
+FROM user_word_statistics uws
+JOIN words w ON w.id = uws.word_id
+WHERE uws.user_id = sqlc.arg('user_id')
+  AND uws.due_at <= sqlc.arg('due_before')
+  AND (sqlc.narg('language_id')::smallint IS NULL OR w.language_id = sqlc.narg('language_id'));
+
+-- name: FindReviewWordsByLanguages :many
+-- Words scheduled for the user in a language pair, most overdue first.
+-- Words that are not due yet are returned after the due ones so callers can use them as distractors.
+SELECT uws.word_id, uws.due_at,
+       (uws.due_at <= sqlc.arg('due_before'))::boolean AS is_due
+FROM user_word_statistics uws
+JOIN words w ON w.id = uws.word_id
+WHERE uws.user_id = sqlc.arg('user_id')
+  AND uws.due_at IS NOT NULL
+  AND w.language_id = sqlc.arg('source_language_id')
+  AND EXISTS (
+      SELECT 1
+      FROM senses s
+      INNER JOIN sense_translations st ON st.source_sense_id = s.id
+      INNER JOIN words tw ON st.target_word_id = tw.id
+      WHERE s.word_id = w.id
+        AND tw.language_id = sqlc.arg('target_language_id')
+  )
+ORDER BY uws.due_at, uws.word_id
+LIMIT sqlc.arg('limit');
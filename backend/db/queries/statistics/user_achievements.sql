@@ -0,0 +1,32 @@
+-- name: CreateUserAchievement :execrows
+-- An achievement is earned once, earning it again affects no rows
+INSERT INTO user_achievements (user_id, achievement_code, earned_at)
+VALUES (sqlc.arg('user_id'), sqlc.arg('achievement_code'), sqlc.arg('earned_at'))
+ON CONFLICT (user_id, achievement_code) DO NOTHING;
+
+-- name: FindUserAchievementsByUserID :many
+SELECT user_id, achievement_code, earned_at
+FROM user_achievements
+WHERE user_id = sqlc.arg('user_id')
+ORDER BY earned_at, achievement_code;
+
+-- name: CountMasteredWords :one
+-- Words of a user whose correct streak reached min_streak
+SELECT COUNT(*)
+FROM user_word_statistics
+WHERE user_id = sqlc.arg('user_id')
+  AND streak >= sqlc.arg('min_streak')::int;
+
+-- name: CountCompletedSessionsByLevel :many
+-- Ended sessions of a user with at least one answer, by level code
+SELECT l.code AS level_code, COUNT(*) AS completed_sessions
+FROM vocab_game_sessions s
+JOIN levels l ON l.id = s.level_id
+WHERE s.user_id = sqlc.arg('user_id')
+  AND s.ended_at IS NOT NULL
+  AND EXISTS (
+      SELECT 1
+      FROM vocab_game_question_answers a
+      WHERE a.session_id = s.id
+  )
+GROUP BY l.code;
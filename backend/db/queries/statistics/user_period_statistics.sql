@@ -0,0 +1,87 @@
+-- name: RecordUserPeriodStatisticsAnswer :exec
+INSERT INTO user_period_statistics (
+    user_id, period_type, period_start, source_language_id, target_language_id, level_id,
+    total_questions, total_correct, last_played_at
+) VALUES (
+    sqlc.arg('user_id'),
+    sqlc.arg('period_type'),
+    sqlc.arg('period_start'),
+    sqlc.arg('source_language_id'),
+    sqlc.arg('target_language_id'),
+    sqlc.arg('level_id'),
+    1,
+    CASE WHEN sqlc.arg('is_correct')::boolean THEN 1 ELSE 0 END,
+    sqlc.arg('answered_at')
+)
+ON CONFLICT (user_id, period_type, period_start, source_language_id, target_language_id, level_id) DO UPDATE
+SET total_questions = user_period_statistics.total_questions + 1,
+    total_correct = user_period_statistics.total_correct + EXCLUDED.total_correct,
+    last_played_at = GREATEST(user_period_statistics.last_played_at, EXCLUDED.last_played_at);
+
+-- name: FindCorrectAnswersLeaderboard :many
+-- Users ranked by correct answers in a period, optionally within a language pair and level;
+-- for the same number of correct answers, fewer questions (better accuracy) come first
+WITH totals AS (
+    SELECT ups.user_id,
+           SUM(ups.total_questions)::int AS total_questions,
+           SUM(ups.total_correct)::int AS total_correct
+    FROM user_period_statistics ups
+    WHERE ups.period_type = sqlc.arg('period_type')
+      AND ups.period_start = sqlc.arg('period_start')
+      AND (sqlc.narg('source_language_id')::smallint IS NULL OR ups.source_language_id = sqlc.narg('source_language_id'))
+      AND (sqlc.narg('target_language_id')::smallint IS NULL OR ups.target_language_id = sqlc.narg('target_language_id'))
+      AND (sqlc.narg('level_id')::bigint IS NULL OR ups.level_id = sqlc.narg('level_id'))
+    GROUP BY ups.user_id
+)
+SELECT RANK() OVER (ORDER BY t.total_correct DESC) AS rank,
+       t.user_id,
+       COALESCE(p.display_name, u.username, '')::text AS display_name,
+       p.avatar_url,
+       t.total_questions, t.total_correct
+FROM totals t
+JOIN users u ON u.id = t.user_id
+LEFT JOIN user_profiles p ON p.user_id = t.user_id
+ORDER BY rank, t.total_questions, t.user_id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: FindAccuracyLeaderboard :many
+-- Users with at least min_questions answers in a period ranked by accuracy,
+-- optionally within a language pair and level; ties are broken by the number of answers
+WITH totals AS (
+    SELECT ups.user_id,
+           SUM(ups.total_questions)::int AS total_questions,
+           SUM(ups.total_correct)::int AS total_correct
+    FROM user_period_statistics ups
+    WHERE ups.period_type = sqlc.arg('period_type')
+      AND ups.period_start = sqlc.arg('period_start')
+      AND (sqlc.narg('source_language_id')::smallint IS NULL OR ups.source_language_id = sqlc.narg('source_language_id'))
+      AND (sqlc.narg('target_language_id')::smallint IS NULL OR ups.target_language_id = sqlc.narg('target_language_id'))
+      AND (sqlc.narg('level_id')::bigint IS NULL OR ups.level_id = sqlc.narg('level_id'))
+    GROUP BY ups.user_id
+    HAVING SUM(ups.total_questions) >= sqlc.arg('min_questions')::int
+)
+SELECT RANK() OVER (ORDER BY t.total_correct::float8 / t.total_questions DESC) AS rank,
+       t.user_id,
+       COALESCE(p.display_name, u.username, '')::text AS display_name,
+       p.avatar_url,
+       t.total_questions, t.total_correct
+FROM totals t
+JOIN users u ON u.id = t.user_id
+LEFT JOIN user_profiles p ON p.user_id = t.user_id
+ORDER BY rank, t.total_questions DESC, t.user_id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountPeriodLeaderboardUsers :one
+-- Number of users with at least min_questions answers in a period, optionally within a language pair and level
+SELECT COUNT(*)
+FROM (
+    SELECT ups.user_id
+    FROM user_period_statistics ups
+    WHERE ups.period_type = sqlc.arg('period_type')
+      AND ups.period_start = sqlc.arg('period_start')
+      AND (sqlc.narg('source_language_id')::smallint IS NULL OR ups.source_language_id = sqlc.narg('source_language_id'))
+      AND (sqlc.narg('target_language_id')::smallint IS NULL OR ups.target_language_id = sqlc.narg('target_language_id'))
+      AND (sqlc.narg('level_id')::bigint IS NULL OR ups.level_id = sqlc.narg('level_id'))
+    GROUP BY ups.user_id
+    HAVING SUM(ups.total_questions) >= sqlc.arg('min_questions')::int
+) board;
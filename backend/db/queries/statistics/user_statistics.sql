@@ -0,0 +1,106 @@
+-- name: RecordUserStatisticsAnswer :exec
+-- played_on is the day of the answer in the user's time zone. The streak grows on the first answer
+-- of the day following the last day played, and restarts at 1 after a day without answers
+INSERT INTO user_statistics (
+    user_id, total_questions, total_correct, last_played_at,
+    current_streak, longest_streak, last_played_on, total_xp
+) VALUES (
+    sqlc.arg('user_id'),
+    1,
+    CASE WHEN sqlc.arg('is_correct')::boolean THEN 1 ELSE 0 END,
+    sqlc.arg('answered_at'),
+    1,
+    1,
+    sqlc.arg('played_on'),
+    sqlc.arg('xp')
+)
+ON CONFLICT (user_id) DO UPDATE
+SET total_questions = COALESCE(user_statistics.total_questions, 0) + 1,
+    total_correct = COALESCE(user_statistics.total_correct, 0) + EXCLUDED.total_correct,
+    last_played_at = GREATEST(user_statistics.last_played_at, EXCLUDED.last_played_at),
+    current_streak = CASE
+        WHEN user_statistics.last_played_on >= EXCLUDED.last_played_on THEN COALESCE(user_statistics.current_streak, 0)
+        WHEN user_statistics.last_played_on = EXCLUDED.last_played_on - 1 THEN COALESCE(user_statistics.current_streak, 0) + 1
+        ELSE 1
+    END,
+    longest_streak = GREATEST(COALESCE(user_statistics.longest_streak, 0), CASE
+        WHEN user_statistics.last_played_on >= EXCLUDED.last_played_on THEN COALESCE(user_statistics.current_streak, 0)
+        WHEN user_statistics.last_played_on = EXCLUDED.last_played_on - 1 THEN COALESCE(user_statistics.current_streak, 0) + 1
+        ELSE 1
+    END),
+    last_played_on = GREATEST(user_statistics.last_played_on, EXCLUDED.last_played_on),
+    total_xp = COALESCE(user_statistics.total_xp, 0) + EXCLUDED.total_xp;
+
+-- name: RecordUserStatisticsSession :exec
+INSERT INTO user_statistics (
+    user_id, total_sessions, total_time_seconds, last_played_at
+) VALUES (
+    sqlc.arg('user_id'),
+    1,
+    sqlc.arg('duration_seconds'),
+    sqlc.arg('ended_at')
+)
+ON CONFLICT (user_id) DO UPDATE
+SET total_sessions = COALESCE(user_statistics.total_sessions, 0) + 1,
+    total_time_seconds = COALESCE(user_statistics.total_time_seconds, 0) + EXCLUDED.total_time_seconds,
+    last_played_at = GREATEST(user_statistics.last_played_at, EXCLUDED.last_played_at);
+
+-- name: FindUserStatisticsByUserID :one
+SELECT user_id, total_sessions, total_questions, total_correct,
+       total_time_seconds, last_played_at,
+       current_streak, longest_streak, last_played_on, total_xp
+FROM user_statistics
+WHERE user_id = $1;
+
+-- name: FindUserTimezone :one
+-- Time zone of the user the streak days are counted in, NULL for UTC
+SELECT timezone
+FROM user_profiles
+WHERE user_id = $1;
+
+-- name: FindStreakLeaderboard :many
+-- Users with a streak still alive at now ranked by current streak, restricted to the users who played
+-- in a period, optionally within a language pair and level. A streak is alive if its last day played
+-- is yesterday or today in the user's time zone
+SELECT RANK() OVER (ORDER BY us.current_streak DESC) AS rank,
+       us.user_id,
+       COALESCE(p.display_name, u.username, '')::text AS display_name,
+       p.avatar_url,
+       COALESCE(us.current_streak, 0)::int AS current_streak,
+       COALESCE(us.longest_streak, 0)::int AS longest_streak,
+       us.last_played_on
+FROM user_statistics us
+JOIN users u ON u.id = us.user_id
+LEFT JOIN user_profiles p ON p.user_id = us.user_id
+WHERE us.current_streak > 0
+  AND us.last_played_on >= (sqlc.arg('now')::timestamptz AT TIME ZONE COALESCE(p.timezone, 'UTC'))::date - 1
+  AND EXISTS (
+      SELECT 1
+      FROM user_period_statistics ups
+      WHERE ups.user_id = us.user_id
+        AND ups.period_type = sqlc.arg('period_type')
+        AND ups.period_start = sqlc.arg('period_start')
+        AND (sqlc.narg('source_language_id')::smallint IS NULL OR ups.source_language_id = sqlc.narg('source_language_id'))
+        AND (sqlc.narg('target_language_id')::smallint IS NULL OR ups.target_language_id = sqlc.narg('target_language_id'))
+        AND (sqlc.narg('level_id')::bigint IS NULL OR ups.level_id = sqlc.narg('level_id'))
+  )
+ORDER BY rank, us.longest_streak DESC, us.user_id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountStreakLeaderboardUsers :one
+-- Number of users on the streak leaderboard with the same filters as FindStreakLeaderboard
+SELECT COUNT(*)
+FROM user_statistics us
+LEFT JOIN user_profiles p ON p.user_id = us.user_id
+WHERE us.current_streak > 0
+  AND us.last_played_on >= (sqlc.arg('now')::timestamptz AT TIME ZONE COALESCE(p.timezone, 'UTC'))::date - 1
+  AND EXISTS (
+      SELECT 1
+      FROM user_period_statistics ups
+      WHERE ups.user_id = us.user_id
+        AND ups.period_type = sqlc.arg('period_type')
+        AND ups.period_start = sqlc.arg('period_start')
+        AND (sqlc.narg('source_language_id')::smallint IS NULL OR ups.source_language_id = sqlc.narg('source_language_id'))
+        AND (sqlc.narg('target_language_id')::smallint IS NULL OR ups.target_language_id = sqlc.narg('target_language_id'))
+        AND (sqlc.narg('level_id')::bigint IS NULL OR ups.level_id = sqlc.narg('level_id'))
+  );
@@ -0,0 +1,23 @@
+-- name: RecordUserTopicStatisticsAnswer :exec
+INSERT INTO user_topic_statistics (
+    user_id, topic_id, total_questions, total_correct, last_played_at
+)
+SELECT sqlc.arg('user_id')::bigint,
+       wt.topic_id,
+       1,
+       CASE WHEN sqlc.arg('is_correct')::boolean THEN 1 ELSE 0 END,
+       sqlc.arg('answered_at')::timestamp
+FROM word_topics wt
+WHERE wt.word_id = sqlc.arg('word_id')
+ON CONFLICT (user_id, topic_id) DO UPDATE
+SET total_questions = COALESCE(user_topic_statistics.total_questions, 0) + 1,
+    total_correct = COALESCE(user_topic_statistics.total_correct, 0) + EXCLUDED.total_correct,
+    last_played_at = GREATEST(user_topic_statistics.last_played_at, EXCLUDED.last_played_at);
+
+-- name: FindUserTopicStatisticsByUserID :many
+SELECT uts.user_id, uts.topic_id, t.code AS topic_code, t.name AS topic_name,
+       uts.total_questions, uts.total_correct, uts.last_played_at
+FROM user_topic_statistics uts
+JOIN topics t ON t.id = uts.topic_id
+WHERE uts.user_id = $1
+ORDER BY uts.total_questions DESC, uts.topic_id;
@@ -0,0 +1,33 @@
+-- name: RecordUserWordStatisticsAnswer :exec
+INSERT INTO user_word_statistics (
+    user_id, word_id, correct_count, wrong_count, last_answered_at, streak
+) VALUES (
+    sqlc.arg('user_id'),
+    sqlc.arg('word_id'),
+    CASE WHEN sqlc.arg('is_correct')::boolean THEN 1 ELSE 0 END,
+    CASE WHEN sqlc.arg('is_correct')::boolean THEN 0 ELSE 1 END,
+    sqlc.arg('answered_at'),
+    CASE WHEN sqlc.arg('is_correct')::boolean THEN 1 ELSE 0 END
+)
+ON CONFLICT (user_id, word_id) DO UPDATE
+SET correct_count = COALESCE(user_word_statistics.correct_count, 0) + EXCLUDED.correct_count,
+    wrong_count = COALESCE(user_word_statistics.wrong_count, 0) + EXCLUDED.wrong_count,
+    last_answered_at = GREATEST(user_word_statistics.last_answered_at, EXCLUDED.last_answered_at),
+    streak = CASE
+        WHEN EXCLUDED.correct_count > 0 THEN COALESCE(user_word_statistics.streak, 0) + 1
+        ELSE 0
+    END;
+
+-- name: FindUserWordStatisticsByUserID :many
+SELECT uws.user_id, uws.word_id, w.lemma, w.language_id,
+       uws.correct_count, uws.wrong_count, uws.streak, uws.last_answered_at
+FROM user_word_statistics uws
+JOIN words w ON w.id = uws.word_id
+WHERE uws.user_id = sqlc.arg('user_id')
+ORDER BY uws.last_answered_at DESC NULLS LAST, uws.word_id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountUserWordStatisticsByUserID :one
+SELECT COUNT(*)
+FROM user_word_statistics
+WHERE user_id = sqlc.arg('user_id');
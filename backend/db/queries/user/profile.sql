@@ -1,10 +1,10 @@
 -- name: CreateUserProfile :one
-INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at;
+INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio, timezone)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at;
 
 -- name: GetUserProfile :one
-SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+SELECT user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at
 FROM user_profiles
 WHERE user_id = $1;
 
@@ -14,6 +14,8 @@ SET display_name = COALESCE($2, display_name),
     avatar_url = COALESCE($3, avatar_url),
     birth_day = COALESCE($4, birth_day),
     bio = COALESCE($5, bio),
+    timezone = COALESCE($6, timezone),
+    share_progress = COALESCE($7, share_progress),
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at;
+RETURNING user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at;
@@ -4,6 +4,9 @@ CREATE SCHEMA public;
 
 -- PostgreSQL Migration: Initial Schema
 
+-- Trigram matching for fuzzy dictionary search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 CREATE TABLE languages (
     id           SMALLSERIAL PRIMARY KEY, -- language id
     code         VARCHAR(10) NOT NULL UNIQUE, -- language code: 'en', 'vi', 'zh', ...
@@ -55,6 +58,13 @@ CREATE TABLE words (
 CREATE INDEX idx_words_lang_lemma ON words(language_id, lemma);
 CREATE INDEX idx_words_lang_norm ON words(language_id, lemma_normalized);
 CREATE INDEX idx_words_lang_search ON words(language_id, search_key);
+-- Serves the frequency-rank windows of distractor candidates
+CREATE INDEX idx_words_lang_freq ON words(language_id, frequency_rank);
+-- Trigram indexes serve substring, prefix and similarity search; GiST also orders words by
+-- trigram distance for "did you mean" suggestions
+CREATE INDEX idx_words_lemma_trgm ON words USING GIST (lemma gist_trgm_ops);
+CREATE INDEX idx_words_norm_trgm ON words USING GIST (lemma_normalized gist_trgm_ops);
+CREATE INDEX idx_words_search_trgm ON words USING GIST (search_key gist_trgm_ops);
 
 CREATE TABLE senses (
     id                     BIGSERIAL PRIMARY KEY, -- sense id
@@ -78,6 +88,8 @@ CREATE TABLE senses (
 );
 
 CREATE INDEX idx_senses_word_order ON senses(word_id, sense_order);
+-- Trigram index for reverse search over definitions
+CREATE INDEX idx_senses_definition_trgm ON senses USING GIN (definition gin_trgm_ops);
 
 CREATE TABLE sense_translations (
     id                 BIGSERIAL PRIMARY KEY, -- sense translation id
@@ -123,6 +135,8 @@ CREATE TABLE word_topics (
         FOREIGN KEY (topic_id) REFERENCES topics(id)
 );
 
+CREATE INDEX idx_wt_topic ON word_topics(topic_id, word_id);
+
 CREATE TABLE examples (
     id              BIGSERIAL PRIMARY KEY, -- example sentence id
     source_sense_id BIGINT NOT NULL, -- FK -> senses.id (sense being illustrated)
@@ -223,6 +237,8 @@ CREATE TABLE user_profiles (
     avatar_url    VARCHAR(500), -- avatar URL
     birth_day     DATE, -- birthday (YYYY-MM-DD)
     bio           TEXT, -- user bio
+    timezone      VARCHAR(64), -- IANA time zone of the user, e.g. 'Asia/Ho_Chi_Minh' (NULL = UTC)
+    share_progress BOOLEAN DEFAULT FALSE, -- whether other users may watch the user's live progress
     created_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- profile creation time
     updated_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- profile last update time
     CONSTRAINT fk_up_user
@@ -236,6 +252,10 @@ CREATE TABLE user_statistics (
     total_correct       INTEGER DEFAULT 0, -- total number of correct answers
     total_time_seconds  INTEGER DEFAULT 0, -- total play time (in seconds)
     last_played_at      TIMESTAMP, -- last play time
+    current_streak      INTEGER DEFAULT 0, -- consecutive days played, ending on last_played_on
+    longest_streak      INTEGER DEFAULT 0, -- longest run of consecutive days played
+    last_played_on      DATE, -- last day played in the user's time zone, anchors current_streak
+    total_xp            INTEGER DEFAULT 0, -- experience points earned by correct answers
     CONSTRAINT fk_us_user
         FOREIGN KEY (user_id) REFERENCES users(id)
 );
@@ -247,6 +267,10 @@ CREATE TABLE user_word_statistics (
     wrong_count      INTEGER DEFAULT 0, -- number of times this word was answered incorrectly
     last_answered_at TIMESTAMP, -- most recent time this word was answered
     streak           INTEGER DEFAULT 0, -- current correct streak for this word
+    ease_factor      DOUBLE PRECISION NOT NULL DEFAULT 2.5, -- SM-2 ease factor (>= 1.3)
+    interval_days    INTEGER NOT NULL DEFAULT 0, -- SM-2 interval until the next review, in days
+    repetitions      INTEGER NOT NULL DEFAULT 0, -- number of consecutive successful reviews
+    due_at           TIMESTAMP, -- next time this word should be reviewed (NULL = never scheduled)
     PRIMARY KEY (user_id, word_id),
     CONSTRAINT fk_uws_user
         FOREIGN KEY (user_id) REFERENCES users(id),
@@ -254,6 +278,8 @@ CREATE TABLE user_word_statistics (
         FOREIGN KEY (word_id) REFERENCES words(id)
 );
 
+CREATE INDEX idx_uws_user_due ON user_word_statistics(user_id, due_at);
+
 CREATE TABLE user_topic_statistics (
     user_id         BIGINT NOT NULL, -- FK -> users.id
     topic_id        BIGINT NOT NULL, -- FK -> topics.id
@@ -267,6 +293,39 @@ CREATE TABLE user_topic_statistics (
         FOREIGN KEY (topic_id) REFERENCES topics(id)
 );
 
+-- Answer totals per period, language pair and level, kept up to date on every answer
+-- so that leaderboards never scan vocab_game_question_answers
+CREATE TABLE user_period_statistics (
+    user_id             BIGINT NOT NULL, -- FK -> users.id
+    period_type         VARCHAR(20) NOT NULL, -- 'weekly', 'monthly' or 'all_time'
+    period_start        DATE NOT NULL, -- first day of the period (UTC), 1970-01-01 for 'all_time'
+    source_language_id  SMALLINT NOT NULL, -- FK -> languages.id (question language)
+    target_language_id  SMALLINT NOT NULL, -- FK -> languages.id (answer language)
+    level_id            BIGINT NOT NULL DEFAULT 0, -- level of the session (0 = session without a level)
+    total_questions     INTEGER NOT NULL DEFAULT 0, -- answered questions in the period
+    total_correct       INTEGER NOT NULL DEFAULT 0, -- correct answers in the period
+    last_played_at      TIMESTAMP, -- most recent answer in the period
+    PRIMARY KEY (user_id, period_type, period_start, source_language_id, target_language_id, level_id),
+    CONSTRAINT fk_ups_user
+        FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_ups_source_lang
+        FOREIGN KEY (source_language_id) REFERENCES languages(id),
+    CONSTRAINT fk_ups_target_lang
+        FOREIGN KEY (target_language_id) REFERENCES languages(id)
+);
+
+CREATE INDEX idx_ups_board ON user_period_statistics(period_type, period_start, source_language_id, target_language_id, level_id);
+
+-- Achievements earned by users; the catalog of achievements is defined in code
+CREATE TABLE user_achievements (
+    user_id          BIGINT NOT NULL, -- FK -> users.id
+    achievement_code VARCHAR(50) NOT NULL, -- code of the achievement, e.g. 'streak_7_days'
+    earned_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- time the achievement was earned
+    PRIMARY KEY (user_id, achievement_code),
+    CONSTRAINT fk_ua_user
+        FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
 CREATE TABLE vocab_game_sessions (
     id                  BIGSERIAL PRIMARY KEY, -- game session id
     user_id             BIGINT NOT NULL, -- FK -> users.id
@@ -279,6 +338,9 @@ CREATE TABLE vocab_game_sessions (
     correct_questions   SMALLINT DEFAULT 0, -- total number of correct answers
     started_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- session start time
     ended_at            TIMESTAMP, -- session end time
+    option_count        SMALLINT DEFAULT 4, -- number of options of multiple-choice questions
+    question_time_limit_ms INTEGER, -- time allowed to answer each question (NULL = no limit)
+    challenge_date      DATE, -- day of the daily challenge (NULL for other sessions)
     CONSTRAINT fk_vgs_user
         FOREIGN KEY (user_id) REFERENCES users(id),
     CONSTRAINT fk_vgs_source_lang
@@ -292,17 +354,23 @@ CREATE TABLE vocab_game_sessions (
 );
 
 CREATE INDEX idx_vgs_user_time ON vocab_game_sessions(user_id, started_at);
+-- One daily challenge attempt per user, day and language pair
+CREATE UNIQUE INDEX uq_vgs_daily_challenge ON vocab_game_sessions(user_id, challenge_date, source_language_id, target_language_id)
+    WHERE challenge_date IS NOT NULL;
+CREATE INDEX idx_vgs_challenge_board ON vocab_game_sessions(challenge_date, source_language_id, target_language_id)
+    WHERE challenge_date IS NOT NULL;
 
 CREATE TABLE vocab_game_questions (
     id                     BIGSERIAL PRIMARY KEY, -- game question id
     session_id             BIGINT NOT NULL, -- FK -> vocab_game_sessions.id
     question_order         SMALLINT NOT NULL, -- question order within the session
-    question_type          VARCHAR(30) NOT NULL, -- question type: 'word_to_translation', ...
+    question_type          VARCHAR(30) NOT NULL, -- question type: 'word_to_translation', 'translation_to_word', 'definition_to_word', 'example_cloze', 'pronunciation_to_word', 'typed_translation'
     source_word_id         BIGINT NOT NULL, -- FK -> words.id (source word)
     source_sense_id        BIGINT, -- FK -> senses.id (specific sense, if used)
     correct_target_word_id BIGINT NOT NULL, -- FK -> words.id (correct answer)
     source_language_id     SMALLINT NOT NULL, -- FK -> languages.id (question language)
     target_language_id     SMALLINT NOT NULL, -- FK -> languages.id (answer language)
+    prompt_text            TEXT, -- text shown to the learner (translation, definition, cloze sentence, IPA); NULL = source word lemma
     created_at             TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- question creation time
     CONSTRAINT fk_vgq_session
         FOREIGN KEY (session_id) REFERENCES vocab_game_sessions(id),
@@ -341,6 +409,8 @@ CREATE TABLE vocab_game_question_answers (
     selected_option_id BIGINT, -- FK -> vocab_game_question_options.id (user's chosen answer)
     is_correct         BOOLEAN NOT NULL DEFAULT FALSE, -- TRUE if the answer is correct
     response_time_ms   INTEGER, -- response time (ms)
+    answer_text        TEXT, -- text typed by the user (typed questions only)
+    match_result       VARCHAR(10), -- grading of the typed answer: 'correct', 'almost', 'wrong'
     answered_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- answer time
     CONSTRAINT fk_vgqa_question
         FOREIGN KEY (question_id) REFERENCES vocab_game_questions(id),
@@ -349,11 +419,36 @@ CREATE TABLE vocab_game_question_answers (
     CONSTRAINT fk_vgqa_user
         FOREIGN KEY (user_id) REFERENCES users(id),
     CONSTRAINT fk_vgqa_option
-        FOREIGN KEY (selected_option_id) REFERENCES vocab_game_question_options(id)
+        FOREIGN KEY (selected_option_id) REFERENCES vocab_game_question_options(id),
+    CONSTRAINT uq_vgqa_question_user
+        UNIQUE (question_id, user_id) -- a question is answered at most once
 );
 
 CREATE INDEX idx_vgqa_user_time ON vocab_game_question_answers(user_id, answered_at);
 
+-- Head-to-head duels: every player plays an own session generated from the same seed
+CREATE TABLE vocab_game_duels (
+    id         BIGSERIAL PRIMARY KEY, -- duel id
+    room_code  VARCHAR(16) NOT NULL, -- code of the room the duel was played in
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- duel start time
+    ended_at   TIMESTAMP -- duel end time
+);
+
+CREATE TABLE vocab_game_duel_players (
+    duel_id    BIGINT NOT NULL, -- FK -> vocab_game_duels.id
+    user_id    BIGINT NOT NULL, -- FK -> users.id
+    session_id BIGINT NOT NULL, -- FK -> vocab_game_sessions.id (session the player answered in)
+    points     SMALLINT NOT NULL DEFAULT 0, -- questions the player answered correctly first
+    rank       SMALLINT, -- final rank, players with the same points share a rank (NULL until the duel ends)
+    PRIMARY KEY (duel_id, user_id),
+    CONSTRAINT fk_vgdp_duel
+        FOREIGN KEY (duel_id) REFERENCES vocab_game_duels(id),
+    CONSTRAINT fk_vgdp_user
+        FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_vgdp_session
+        FOREIGN KEY (session_id) REFERENCES vocab_game_sessions(id)
+);
+
 -- Create function and trigger for updated_at columns
 CREATE OR REPLACE FUNCTION update_updated_at_column()
 RETURNS TRIGGER AS $$
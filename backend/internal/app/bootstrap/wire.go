@@ -7,8 +7,11 @@ import (
 	"github.com/english-coach/backend/internal/app/di"
 	"github.com/english-coach/backend/internal/app/lifecycle"
 	dictadapter "github.com/english-coach/backend/internal/modules/dictionary/adapter/http"
+	reviewadapter "github.com/english-coach/backend/internal/modules/review/adapter/http"
+	statisticsadapter "github.com/english-coach/backend/internal/modules/statistics/adapter/http"
 	useradapter "github.com/english-coach/backend/internal/modules/user/adapter/http"
 	vocabgameadapter "github.com/english-coach/backend/internal/modules/vocabgame/adapter/http"
+	vocabgamews "github.com/english-coach/backend/internal/modules/vocabgame/adapter/ws"
 	"github.com/english-coach/backend/internal/shared/logger"
 	"github.com/gin-gonic/gin"
 )
@@ -113,5 +116,8 @@ func registerRoutes(httpServer *HTTPServer, container *di.Container) {
 		useradapter.RegisterRoutes(apiV1, container.UserHandler, container.AuthMiddleware)
 		dictadapter.RegisterRoutes(apiV1, container.DictionaryHandler)
 		vocabgameadapter.RegisterRoutes(apiV1, container.VocabGameHandler, container.AuthMiddleware)
+		vocabgamews.RegisterRoutes(apiV1, container.DuelHandler)
+		statisticsadapter.RegisterRoutes(apiV1, container.StatisticsHandler, container.AuthMiddleware)
+		reviewadapter.RegisterRoutes(apiV1, container.ReviewHandler, container.AuthMiddleware)
 	}
 }
@@ -2,11 +2,22 @@ package di
 
 import (
 	"context"
+	"time"
 
 	config "github.com/english-coach/backend/configs"
 	dictadapter "github.com/english-coach/backend/internal/modules/dictionary/adapter/http"
 	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+	dictsearch "github.com/english-coach/backend/internal/modules/dictionary/infra/search"
 	dictusecase "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	reviewadapter "github.com/english-coach/backend/internal/modules/review/adapter/http"
+	reviewrepo "github.com/english-coach/backend/internal/modules/review/infra/persistence/postgres"
+	reviewrecordreview "github.com/english-coach/backend/internal/modules/review/usecase/record_review"
+	statisticsadapter "github.com/english-coach/backend/internal/modules/statistics/adapter/http"
+	statsrepo "github.com/english-coach/backend/internal/modules/statistics/infra/persistence/postgres"
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
+	statsgetleaderboard "github.com/english-coach/backend/internal/modules/statistics/usecase/get_leaderboard"
+	statsgetsession "github.com/english-coach/backend/internal/modules/statistics/usecase/get_session_statistics"
+	statslistachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/list_achievements"
 	useradapter "github.com/english-coach/backend/internal/modules/user/adapter/http"
 	userrepo "github.com/english-coach/backend/internal/modules/user/infra/persistence/postgres"
 	usergetprofile "github.com/english-coach/backend/internal/modules/user/usecase/get_profile"
@@ -14,11 +25,23 @@ import (
 	userregister "github.com/english-coach/backend/internal/modules/user/usecase/register"
 	userupdateprofile "github.com/english-coach/backend/internal/modules/user/usecase/update_profile"
 	vocabgameadapter "github.com/english-coach/backend/internal/modules/vocabgame/adapter/http"
+	vocabgamews "github.com/english-coach/backend/internal/modules/vocabgame/adapter/ws"
 	gamerepo "github.com/english-coach/backend/internal/modules/vocabgame/infra/persistence/postgres"
+	gamecompletesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/complete_session"
 	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	gamefinishduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/finish_duel"
+	gamegetnextquestion "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_next_question"
+	gamegetsessionreview "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_session_review"
+	gameretrymistakes "github.com/english-coach/backend/internal/modules/vocabgame/usecase/retry_mistakes"
+	gamestartdailychallenge "github.com/english-coach/backend/internal/modules/vocabgame/usecase/start_daily_challenge"
+	gamestartduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/start_duel"
 	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
+	gamewatchprogress "github.com/english-coach/backend/internal/modules/vocabgame/usecase/watch_progress"
 	"github.com/english-coach/backend/internal/platform/db"
+	platformeventbus "github.com/english-coach/backend/internal/platform/eventbus"
 	"github.com/english-coach/backend/internal/shared/auth"
+	"github.com/english-coach/backend/internal/shared/constants"
+	"github.com/english-coach/backend/internal/shared/eventbus"
 	"github.com/english-coach/backend/internal/shared/logger"
 	"github.com/english-coach/backend/internal/transport/http/handler"
 	"github.com/english-coach/backend/internal/transport/http/middleware"
@@ -32,6 +55,10 @@ type Container struct {
 	Config *config.Config
 	Logger *logger.Logger
 	DB     *pgxpool.Pool
+	UoW    *db.UnitOfWork
+
+	// Events
+	EventBus eventbus.Bus
 
 	// Auth
 	JWTManager *auth.JWTManager
@@ -40,20 +67,41 @@ type Container struct {
 	DictionaryRepo *dictrepo.DictionaryRepository
 	GameRepo       *gamerepo.GameRepository
 	UserRepo       *userrepo.UserRepository
+	StatisticsRepo *statsrepo.StatisticsRepository
+	ReviewRepo     *reviewrepo.ReviewRepository
+
+	// Indexes
+	WordPrefixIndex *dictsearch.PrefixIndex
 
 	// Use Cases
-	GetWordDetailUC     *dictusecase.Handler
-	CreateGameSessionUC *gamecreatesession.Handler
-	SubmitAnswerUC      *gamesubmitanswer.Handler
-	RegisterUC          *userregister.Handler
-	LoginUC             *userlogin.Handler
-	GetProfileUC        *usergetprofile.Handler
-	UpdateProfileUC     *userupdateprofile.Handler
+	GetWordDetailUC       *dictusecase.Handler
+	CreateGameSessionUC   *gamecreatesession.Handler
+	SubmitAnswerUC        *gamesubmitanswer.Handler
+	CompleteSessionUC     *gamecompletesession.Handler
+	GetNextQuestionUC     *gamegetnextquestion.Handler
+	GetSessionReviewUC    *gamegetsessionreview.Handler
+	RetryMistakesUC       *gameretrymistakes.Handler
+	StartDailyChallengeUC *gamestartdailychallenge.Handler
+	StartDuelUC           *gamestartduel.Handler
+	FinishDuelUC          *gamefinishduel.Handler
+	WatchProgressUC       *gamewatchprogress.Handler
+	RegisterUC            *userregister.Handler
+	LoginUC               *userlogin.Handler
+	GetProfileUC          *usergetprofile.Handler
+	UpdateProfileUC       *userupdateprofile.Handler
+	GetSessionStatsUC     *statsgetsession.Handler
+	GetLeaderboardUC      *statsgetleaderboard.Handler
+	AwardAchievementsUC   *statsawardachievements.Handler
+	ListAchievementsUC    *statslistachievements.Handler
+	RecordReviewUC        *reviewrecordreview.Handler
 
 	// Handlers
 	DictionaryHandler *dictadapter.Handler
 	VocabGameHandler  *vocabgameadapter.Handler
+	DuelHandler       *vocabgamews.Handler
 	UserHandler       *useradapter.Handler
+	StatisticsHandler *statisticsadapter.Handler
+	ReviewHandler     *reviewadapter.Handler
 	OpenAPIHandler    *handler.OpenAPIHandler
 
 	// Middleware
@@ -61,6 +109,9 @@ type Container struct {
 	ErrorMiddleware  gin.HandlerFunc
 	LoggerMiddleware gin.HandlerFunc
 	AuthMiddleware   gin.HandlerFunc
+
+	// stopBackground stops the background jobs started with the container
+	stopBackground context.CancelFunc
 }
 
 // NewContainer creates a new dependency injection container
@@ -94,6 +145,7 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		return nil, err
 	}
 	container.DB = pool
+	container.UoW = db.NewUnitOfWork(pool)
 	appLogger.Info("Database connection established")
 
 	// Log CORS configuration
@@ -101,6 +153,9 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		logger.Strings("allowed_origins", cfg.CORS.AllowedOrigins),
 	)
 
+	// Initialize event bus; events are only delivered within this process
+	container.EventBus = platformeventbus.NewMemoryBus()
+
 	// Initialize JWT manager
 	container.JWTManager = auth.NewJWTManager(cfg.JWT.Secret, cfg.JWT.Expiration)
 
@@ -108,6 +163,18 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	container.DictionaryRepo = dictrepo.NewDictionaryRepository(pool)
 	container.GameRepo = gamerepo.NewGameRepository(pool)
 	container.UserRepo = userrepo.NewUserRepository(pool)
+	container.StatisticsRepo = statsrepo.NewStatisticsRepository(pool)
+	container.ReviewRepo = reviewrepo.NewReviewRepository(pool)
+
+	// Build the word prefix index, then keep it in step with the words
+	container.WordPrefixIndex = dictsearch.NewPrefixIndex(container.DictionaryRepo.WordRepository(), appLogger)
+	if err := container.WordPrefixIndex.Build(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	container.stopBackground = stopBackground
+	go container.WordPrefixIndex.Run(backgroundCtx, constants.WordPrefixIndexRefreshSeconds*time.Second)
 
 	// Initialize use cases
 	container.GetWordDetailUC = dictusecase.NewHandler(
@@ -124,6 +191,61 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.GameRepo.GameSessionRepository(),
 		container.GameRepo.GameQuestionRepository(),
 		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.SenseRepository(),
+		container.DictionaryRepo.ExampleRepository(),
+		container.DictionaryRepo.PronunciationRepository(),
+		container.ReviewRepo.ReviewStateRepository(),
+		container.UoW,
+		appLogger,
+	)
+
+	container.RecordReviewUC = reviewrecordreview.NewHandler(
+		container.ReviewRepo.ReviewStateRepository(),
+		container.UoW,
+		appLogger,
+	)
+
+	container.AwardAchievementsUC = statsawardachievements.NewHandler(
+		container.StatisticsRepo.AchievementRepository(),
+		appLogger,
+	)
+
+	container.CompleteSessionUC = gamecompletesession.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		container.StatisticsRepo.UserStatisticsRepository(),
+		container.AwardAchievementsUC,
+		container.EventBus,
+		container.UoW,
+		appLogger,
+	)
+
+	container.GetNextQuestionUC = gamegetnextquestion.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		appLogger,
+	)
+
+	container.GetSessionReviewUC = gamegetsessionreview.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		container.DictionaryRepo.WordRepository(),
+		container.GetWordDetailUC,
+		appLogger,
+	)
+
+	container.RetryMistakesUC = gameretrymistakes.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		container.CreateGameSessionUC,
+		appLogger,
+	)
+
+	container.StartDailyChallengeUC = gamestartdailychallenge.NewHandler(
+		container.CreateGameSessionUC,
 		appLogger,
 	)
 
@@ -131,6 +253,35 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.GameRepo.GameAnswerRepository(),
 		container.GameRepo.GameQuestionRepository(),
 		container.GameRepo.GameSessionRepository(),
+		container.StatisticsRepo.UserStatisticsRepository(),
+		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.SenseRepository(),
+		container.DictionaryRepo.LevelRepository(),
+		container.CompleteSessionUC,
+		container.RecordReviewUC,
+		container.AwardAchievementsUC,
+		container.EventBus,
+		container.UoW,
+		appLogger,
+	)
+
+	container.StartDuelUC = gamestartduel.NewHandler(
+		container.GameRepo.DuelRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.CreateGameSessionUC,
+		container.UoW,
+		appLogger,
+	)
+
+	container.FinishDuelUC = gamefinishduel.NewHandler(
+		container.GameRepo.DuelRepository(),
+		container.CompleteSessionUC,
+		appLogger,
+	)
+
+	container.WatchProgressUC = gamewatchprogress.NewHandler(
+		container.GameRepo.ProgressRepository(),
+		container.EventBus,
 		appLogger,
 	)
 
@@ -151,12 +302,31 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.UserRepo.UserProfileRepository(),
 	)
 
+	container.GetSessionStatsUC = statsgetsession.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		container.DictionaryRepo.WordRepository(),
+		appLogger,
+	)
+
+	container.GetLeaderboardUC = statsgetleaderboard.NewHandler(
+		container.StatisticsRepo.LeaderboardRepository(),
+		appLogger,
+	)
+
+	container.ListAchievementsUC = statslistachievements.NewHandler(
+		container.StatisticsRepo.AchievementRepository(),
+		appLogger,
+	)
+
 	// Initialize handlers
 	container.DictionaryHandler = dictadapter.NewHandler(
 		container.DictionaryRepo.LanguageRepository(),
 		container.DictionaryRepo.TopicRepository(),
 		container.DictionaryRepo.LevelRepository(),
 		container.DictionaryRepo.WordRepository(),
+		container.WordPrefixIndex,
 		container.GetWordDetailUC,
 		appLogger,
 	)
@@ -164,9 +334,30 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	container.VocabGameHandler = vocabgameadapter.NewHandler(
 		container.CreateGameSessionUC,
 		container.SubmitAnswerUC,
+		container.CompleteSessionUC,
+		container.GetNextQuestionUC,
+		container.GetSessionReviewUC,
+		container.RetryMistakesUC,
+		container.StartDailyChallengeUC,
+		container.WatchProgressUC,
 		container.GameRepo.GameQuestionRepository(),
 		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameAnswerRepository(),
 		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.SenseRepository(),
+		container.DictionaryRepo.PartOfSpeechRepository(),
+		appLogger,
+	)
+
+	container.DuelHandler = vocabgamews.NewHandler(
+		vocabgamews.NewHub(
+			container.StartDuelUC,
+			container.SubmitAnswerUC,
+			container.FinishDuelUC,
+			appLogger,
+		),
+		container.JWTManager,
+		cfg.CORS.AllowedOrigins,
 		appLogger,
 	)
 
@@ -179,6 +370,19 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.UserRepo.UserProfileRepository(),
 	)
 
+	container.StatisticsHandler = statisticsadapter.NewHandler(
+		container.GetSessionStatsUC,
+		container.GetLeaderboardUC,
+		container.ListAchievementsUC,
+		container.StatisticsRepo.UserStatisticsRepository(),
+		appLogger,
+	)
+
+	container.ReviewHandler = reviewadapter.NewHandler(
+		container.ReviewRepo.ReviewStateRepository(),
+		appLogger,
+	)
+
 	container.OpenAPIHandler = handler.NewOpenAPIHandler(
 		appLogger,
 		"docs/openapi/openapi.yaml",
@@ -195,6 +399,9 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 
 // Close closes all resources in the container
 func (c *Container) Close() error {
+	if c.stopBackground != nil {
+		c.stopBackground()
+	}
 	if c.DB != nil {
 		c.DB.Close()
 	}
@@ -55,14 +55,82 @@ func mapWordsToResponse(words []*domain.Word) []*WordResponse {
 	return result
 }
 
+// Search modes
+const (
+	// SearchModeHeadword searches the headwords of the language
+	SearchModeHeadword = "headword"
+	// SearchModeReverse searches the words of the language by the translations and definitions of their senses
+	SearchModeReverse = "reverse"
+)
+
 // SearchWordsRequest represents the query parameters for word search
 type SearchWordsRequest struct {
 	Query      string `form:"q" binding:"required"`
 	LanguageID int16  `form:"languageId" binding:"required"`
+	Mode       string `form:"mode"` // 'headword' (default) or 'reverse'
 	Page       int    `form:"page"`
 	PageSize   int    `form:"pageSize"`
 	Limit      int    `form:"limit"`
 	Offset     int    `form:"offset"`
+
+	// Filters of the headword mode
+	LevelID        *int64  `form:"levelId"`
+	TopicIDs       []int64 `form:"topicIds" collection_format:"csv"`
+	PartOfSpeechID *int16  `form:"partOfSpeechId"`
+	HasAudio       *bool   `form:"hasAudio"`
+	ScriptCode     *string `form:"scriptCode"` // 'Latn', 'Hani', ...
+}
+
+// SearchFacetsResponse represents the facet counts of a headword search for HTTP response
+type SearchFacetsResponse struct {
+	Levels []*domain.SearchFacet `json:"levels"`
+	Topics []*domain.SearchFacet `json:"topics"`
+}
+
+// ListWordsRequest represents the query parameters for listing words
+type ListWordsRequest struct {
+	LanguageID       int16   `form:"languageId" binding:"required"`
+	LevelID          *int64  `form:"levelId"`
+	TopicIDs         []int64 `form:"topicIds" collection_format:"csv"`
+	Sort             string  `form:"sort"` // 'frequency' (default) or 'alpha'
+	TargetLanguageID *int16  `form:"targetLanguageId"`
+	Page             int     `form:"page"`
+	PageSize         int     `form:"pageSize"`
+	Limit            int     `form:"limit"`
+	Offset           int     `form:"offset"`
+}
+
+// WordListEntryResponse represents a listed word with its primary translation
+type WordListEntryResponse struct {
+	*WordResponse
+	Translation *PrimaryTranslationResponse `json:"translation"`
+}
+
+// PrimaryTranslationResponse represents the primary translation of a listed word, null when untranslated
+type PrimaryTranslationResponse struct {
+	ID           int64   `json:"id"`
+	LanguageID   int16   `json:"language_id"`
+	Lemma        string  `json:"lemma"`
+	Romanization *string `json:"romanization,omitempty"`
+}
+
+// mapWordListEntriesToResponse maps word list entries to WordListEntryResponse slice
+func mapWordListEntriesToResponse(entries []*domain.WordListEntry) []*WordListEntryResponse {
+	responses := make([]*WordListEntryResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = &WordListEntryResponse{
+			WordResponse: mapWordToResponse(entry.Word),
+		}
+		if entry.Translation != nil {
+			responses[i].Translation = &PrimaryTranslationResponse{
+				ID:           entry.Translation.ID,
+				LanguageID:   entry.Translation.LanguageID,
+				Lemma:        entry.Translation.Lemma,
+				Romanization: entry.Translation.Romanization,
+			}
+		}
+	}
+	return responses
 }
 
 // GetLevelsRequest represents the query parameters for getting levels
@@ -104,3 +172,40 @@ type GetWordDetailResponse struct {
 	Pronunciations interface{}             `json:"pronunciations"`
 	Relations      []*WordRelationResponse `json:"relations,omitempty"`
 }
+
+// ReverseSearchWordResponse represents a word found by its meaning, with the sense that matched
+type ReverseSearchWordResponse struct {
+	*WordResponse
+	MatchedSense *MatchedSenseResponse `json:"matched_sense"`
+}
+
+// MatchedSenseResponse represents the sense of a word matching a reverse search query
+type MatchedSenseResponse struct {
+	ID                   int64  `json:"id"`
+	SenseOrder           int16  `json:"sense_order"`
+	PartOfSpeechID       int16  `json:"part_of_speech_id"`
+	Definition           string `json:"definition"`
+	DefinitionLanguageID int16  `json:"definition_language_id"`
+	MatchedBy            string `json:"matched_by"`   // 'translation' or 'definition'
+	MatchedText          string `json:"matched_text"` // Lemma of the matching translation or the definition
+}
+
+// mapReverseSearchResultsToResponse maps reverse search results to ReverseSearchWordResponse slice
+func mapReverseSearchResultsToResponse(results []*domain.ReverseSearchResult) []*ReverseSearchWordResponse {
+	responses := make([]*ReverseSearchWordResponse, len(results))
+	for i, result := range results {
+		responses[i] = &ReverseSearchWordResponse{
+			WordResponse: mapWordToResponse(result.Word),
+			MatchedSense: &MatchedSenseResponse{
+				ID:                   result.Sense.ID,
+				SenseOrder:           result.Sense.SenseOrder,
+				PartOfSpeechID:       result.Sense.PartOfSpeechID,
+				Definition:           result.Sense.Definition,
+				DefinitionLanguageID: result.Sense.DefinitionLanguageID,
+				MatchedBy:            result.MatchedBy,
+				MatchedText:          result.MatchedText,
+			},
+		}
+	}
+	return responses
+}
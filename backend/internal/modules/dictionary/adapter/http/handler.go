@@ -1,11 +1,15 @@
 package http
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
 	dictusecase "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	"github.com/english-coach/backend/internal/shared/constants"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
 	"github.com/english-coach/backend/internal/shared/pagination"
@@ -20,6 +24,7 @@ type Handler struct {
 	topicRepo       domain.TopicRepository
 	levelRepo       domain.LevelRepository
 	wordRepo        domain.WordRepository
+	prefixIndex     domain.WordPrefixIndex
 	getWordDetailUC *dictusecase.Handler
 	logger          logger.ILogger
 }
@@ -30,6 +35,7 @@ func NewHandler(
 	topicRepo domain.TopicRepository,
 	levelRepo domain.LevelRepository,
 	wordRepo domain.WordRepository,
+	prefixIndex domain.WordPrefixIndex,
 	getWordDetailUC *dictusecase.Handler,
 	logger logger.ILogger,
 ) *Handler {
@@ -38,6 +44,7 @@ func NewHandler(
 		topicRepo:       topicRepo,
 		levelRepo:       levelRepo,
 		wordRepo:        wordRepo,
+		prefixIndex:     prefixIndex,
 		getWordDetailUC: getWordDetailUC,
 		logger:          logger,
 	}
@@ -101,11 +108,17 @@ func (h *Handler) GetLevels(c *gin.Context) {
 	response.Success(c, http.StatusOK, levels)
 }
 
-// SearchWords handles GET /api/v1/dictionary/search?q=...&languageId=...&limit=...&offset=...
+// SearchWords handles GET /api/v1/dictionary/search?q=...&languageId=...&mode=...&sourceLanguageId=...&limit=...&offset=...
+// The headword mode searches the words of languageId, optionally filtered by levelId, topicIds,
+// partOfSpeechId, hasAudio and scriptCode; the response carries the number of results per level and
+// topic in "facets" and, when nothing matches, "did you mean" suggestions. The reverse mode finds
+// the words of languageId by their meaning, matching translations written in sourceLanguageId.
 func (h *Handler) SearchWords(c *gin.Context) {
-	ctx := c.Request.Context()
+	// The whole search, count and suggestions included, must answer within the search budget
+	ctx, cancel := context.WithTimeout(c.Request.Context(), constants.DictionarySearchTimeout*time.Millisecond)
+	defer cancel()
 
-	query := c.Query("q")
+	query := strings.TrimSpace(c.Query("q"))
 	if query == "" {
 		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("query parameter (q) is required"))
 		return
@@ -125,6 +138,38 @@ func (h *Handler) SearchWords(c *gin.Context) {
 	}
 	langID := int16(languageID)
 
+	mode := c.DefaultQuery("mode", SearchModeHeadword)
+	if mode != SearchModeHeadword && mode != SearchModeReverse {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("mode must be 'headword' or 'reverse'"))
+		return
+	}
+
+	// The reverse mode needs the language the query is written in, that of the translations it matches
+	var sourceLangID int16
+	if mode == SearchModeReverse {
+		sourceLanguageIDStr := c.Query("sourceLanguageId")
+		if sourceLanguageIDStr == "" {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("sourceLanguageId parameter is required in reverse mode"))
+			return
+		}
+		sourceLanguageID, err := strconv.ParseInt(sourceLanguageIDStr, 10, 16)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid sourceLanguageId"))
+			return
+		}
+		sourceLangID = int16(sourceLanguageID)
+	}
+
+	filter, filtered, err := parseSearchFilter(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	if filtered && mode == SearchModeReverse {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("filters are only supported in headword mode"))
+		return
+	}
+
 	// Parse pagination parameters
 	paginationParams, err := pagination.ParseFromQuery(c)
 	if err != nil {
@@ -145,21 +190,28 @@ func (h *Handler) SearchWords(c *gin.Context) {
 	appLogger.Info("dictionary search started",
 		logger.String("query", query),
 		logger.Int("language_id", int(langID)),
+		logger.String("mode", mode),
+		logger.Bool("filtered", filtered),
 		logger.Int("limit", paginationParams.Limit),
 		logger.Int("offset", paginationParams.Offset),
 		logger.Int("page", paginationParams.Page),
 		logger.Int("pageSize", paginationParams.Size),
 	)
 
+	if mode == SearchModeReverse {
+		h.reverseSearchWords(ctx, c, query, sourceLangID, langID, paginationParams, appLogger)
+		return
+	}
+
 	// Search words
-	words, err := h.wordRepo.SearchWords(ctx, query, langID, paginationParams.Limit, paginationParams.Offset)
+	words, err := h.wordRepo.SearchWords(ctx, query, langID, filter, paginationParams.Limit, paginationParams.Offset)
 	if err != nil {
 		middleware.SetError(c, err)
 		return
 	}
 
 	// Get total count for pagination
-	totalCount, err := h.wordRepo.CountSearchWords(ctx, query, langID)
+	totalCount, err := h.wordRepo.CountSearchWords(ctx, query, langID, filter)
 	if err != nil {
 		h.logger.Error("failed to count search words",
 			logger.Error(err),
@@ -171,18 +223,253 @@ func (h *Handler) SearchWords(c *gin.Context) {
 
 	total := int64(totalCount)
 
+	// Count the results per level and topic
+	facets := &SearchFacetsResponse{
+		Levels: []*domain.SearchFacet{},
+		Topics: []*domain.SearchFacet{},
+	}
+	if levelFacets, err := h.wordRepo.CountSearchWordsByLevel(ctx, query, langID, filter); err != nil {
+		h.logger.Error("failed to count search words by level",
+			logger.Error(err),
+			logger.String("query", query),
+		)
+		// Continue without level facets
+	} else {
+		facets.Levels = levelFacets
+	}
+	if topicFacets, err := h.wordRepo.CountSearchWordsByTopic(ctx, query, langID, filter); err != nil {
+		h.logger.Error("failed to count search words by topic",
+			logger.Error(err),
+			logger.String("query", query),
+		)
+		// Continue without topic facets
+	} else {
+		facets.Topics = topicFacets
+	}
+
+	// Suggest close words only when the query matches nothing at all, filters aside
+	suggestions := []string{}
+	if total == 0 && !filtered {
+		suggestions, err = h.wordRepo.SuggestWords(ctx, query, langID, constants.DictionarySuggestionLimit)
+		if err != nil {
+			h.logger.Error("failed to suggest words",
+				logger.Error(err),
+				logger.String("query", query),
+			)
+			// Continue without suggestions
+			suggestions = []string{}
+		}
+	}
+
 	// Log successful search
 	appLogger.Info("dictionary search completed",
 		logger.String("query", query),
 		logger.Int("results_count", len(words)),
 		logger.Int64("total", total),
+		logger.Int("suggestions_count", len(suggestions)),
 	)
 
 	// Map domain words to response DTOs
 	wordResponses := mapWordsToResponse(words)
 
 	// Return paginated response
-	response.Paginated(c, http.StatusOK, wordResponses, paginationParams, total)
+	response.PaginatedWithExtra(c, http.StatusOK, wordResponses, paginationParams, total, gin.H{
+		"suggestions": suggestions,
+		"facets":      facets,
+	})
+}
+
+// parseSearchFilter parses the optional filters of a headword search and reports whether any is set
+func parseSearchFilter(c *gin.Context) (domain.WordSearchFilter, bool, error) {
+	var filter domain.WordSearchFilter
+
+	if levelIDStr := c.Query("levelId"); levelIDStr != "" {
+		levelID, err := strconv.ParseInt(levelIDStr, 10, 64)
+		if err != nil {
+			return filter, false, sharederrors.ErrInvalidParameter.WithDetails("invalid levelId")
+		}
+		filter.LevelID = &levelID
+	}
+
+	topicIDs, err := parseTopicIDs(c)
+	if err != nil {
+		return filter, false, err
+	}
+	filter.TopicIDs = topicIDs
+
+	if partOfSpeechIDStr := c.Query("partOfSpeechId"); partOfSpeechIDStr != "" {
+		partOfSpeechID, err := strconv.ParseInt(partOfSpeechIDStr, 10, 16)
+		if err != nil {
+			return filter, false, sharederrors.ErrInvalidParameter.WithDetails("invalid partOfSpeechId")
+		}
+		partOfSpeechID16 := int16(partOfSpeechID)
+		filter.PartOfSpeechID = &partOfSpeechID16
+	}
+
+	if hasAudioStr := c.Query("hasAudio"); hasAudioStr != "" {
+		hasAudio, err := strconv.ParseBool(hasAudioStr)
+		if err != nil {
+			return filter, false, sharederrors.ErrInvalidParameter.WithDetails("hasAudio must be true or false")
+		}
+		filter.HasAudio = &hasAudio
+	}
+
+	if scriptCode := strings.TrimSpace(c.Query("scriptCode")); scriptCode != "" {
+		filter.ScriptCode = &scriptCode
+	}
+
+	filtered := filter.LevelID != nil || len(filter.TopicIDs) > 0 || filter.PartOfSpeechID != nil ||
+		filter.HasAudio != nil || filter.ScriptCode != nil
+
+	return filter, filtered, nil
+}
+
+// reverseSearchWords responds to a reverse search: the words found by their meaning,
+// each with the sense whose translation or definition matched the query
+func (h *Handler) reverseSearchWords(ctx context.Context, c *gin.Context, query string, sourceLangID, langID int16, paginationParams *pagination.Params, appLogger logger.ILogger) {
+	results, err := h.wordRepo.ReverseSearchWords(ctx, query, sourceLangID, langID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// Get total count for pagination
+	totalCount, err := h.wordRepo.CountReverseSearchWords(ctx, query, sourceLangID, langID)
+	if err != nil {
+		h.logger.Error("failed to count reverse search words",
+			logger.Error(err),
+			logger.String("query", query),
+		)
+		// Continue without total count
+		totalCount = len(results)
+	}
+
+	total := int64(totalCount)
+
+	// Log successful search
+	appLogger.Info("dictionary reverse search completed",
+		logger.String("query", query),
+		logger.Int("results_count", len(results)),
+		logger.Int64("total", total),
+	)
+
+	// Suggestions are only made for headwords
+	response.PaginatedWithExtra(c, http.StatusOK, mapReverseSearchResultsToResponse(results), paginationParams, total, gin.H{
+		"suggestions": []string{},
+	})
+}
+
+// ListWords handles GET /api/v1/dictionary/words?languageId=...&levelId=...&topicIds=...&sort=...&targetLanguageId=...
+// It lists the words of languageId, optionally of a level and of some topics, sorted by frequency
+// (default) or alphabetically, each with its primary translation into targetLanguageId when given.
+func (h *Handler) ListWords(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Parse language ID (required)
+	languageIDStr := c.Query("languageId")
+	if languageIDStr == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("languageId parameter is required"))
+		return
+	}
+
+	languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+		return
+	}
+
+	filter := domain.WordListFilter{
+		LanguageID: int16(languageID),
+		Sort:       c.DefaultQuery("sort", domain.WordListSortFrequency),
+	}
+	if filter.Sort != domain.WordListSortFrequency && filter.Sort != domain.WordListSortAlpha {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("sort must be 'frequency' or 'alpha'"))
+		return
+	}
+
+	if levelIDStr := c.Query("levelId"); levelIDStr != "" {
+		levelID, err := strconv.ParseInt(levelIDStr, 10, 64)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid levelId"))
+			return
+		}
+		filter.LevelID = &levelID
+	}
+
+	filter.TopicIDs, err = parseTopicIDs(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	if targetLanguageIDStr := c.Query("targetLanguageId"); targetLanguageIDStr != "" {
+		targetLanguageID, err := strconv.ParseInt(targetLanguageIDStr, 10, 16)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid targetLanguageId"))
+			return
+		}
+		targetLanguageID16 := int16(targetLanguageID)
+		filter.TargetLanguageID = &targetLanguageID16
+	}
+
+	// Parse pagination parameters
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	entries, err := h.wordRepo.ListWords(ctx, filter, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// Get total count for pagination
+	totalCount, err := h.wordRepo.CountListWords(ctx, filter)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Paginated(c, http.StatusOK, mapWordListEntriesToResponse(entries), paginationParams, int64(totalCount))
+}
+
+// AutocompleteWords handles GET /api/v1/dictionary/suggest?q=...&languageId=...&limit=...
+// It suggests the words starting with what the user typed so far from the in-memory prefix index,
+// the most frequent first, and is meant to be called on every keystroke.
+func (h *Handler) AutocompleteWords(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("query parameter (q) is required"))
+		return
+	}
+
+	// Parse language ID (required)
+	languageIDStr := c.Query("languageId")
+	if languageIDStr == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("languageId parameter is required"))
+		return
+	}
+
+	languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+		return
+	}
+
+	limit := constants.DefaultAutocompleteLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > constants.MaxAutocompleteLimit {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("limit must be between 1 and 20"))
+			return
+		}
+	}
+
+	words := h.prefixIndex.SuggestWords(int16(languageID), query, limit)
+
+	response.Success(c, http.StatusOK, mapWordsToResponse(words))
 }
 
 // GetWordDetail handles GET /api/v1/dictionary/words/:wordId
@@ -271,3 +558,18 @@ func (h *Handler) GetWordDetail(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, resp)
 }
+
+// parseTopicIDs parses the optional topicIds parameter, a comma-separated list that may also be repeated
+func parseTopicIDs(c *gin.Context) ([]int64, error) {
+	var topicIDs []int64
+	for _, topicIDsStr := range c.QueryArray("topicIds") {
+		for _, topicIDStr := range strings.Split(topicIDsStr, ",") {
+			topicID, err := strconv.ParseInt(strings.TrimSpace(topicIDStr), 10, 64)
+			if err != nil {
+				return nil, sharederrors.ErrInvalidParameter.WithDetails("invalid topicIds")
+			}
+			topicIDs = append(topicIDs, topicID)
+		}
+	}
+	return topicIDs, nil
+}
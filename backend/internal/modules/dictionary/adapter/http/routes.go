@@ -18,6 +18,8 @@ func RegisterRoutes(router *gin.RouterGroup, handler *Handler) {
 	dictionaryGroup := router.Group("/dictionary")
 	{
 		dictionaryGroup.GET("/search", handler.SearchWords)
+		dictionaryGroup.GET("/suggest", handler.AutocompleteWords)
+		dictionaryGroup.GET("/words", handler.ListWords)
 		dictionaryGroup.GET("/words/:wordId", handler.GetWordDetail)
 	}
 }
@@ -0,0 +1,9 @@
+package domain
+
+// WordPrefixIndex finds words by the beginning of their lemma, normalized form or search key
+// without querying the database, fast enough to be called on every keystroke
+type WordPrefixIndex interface {
+	// SuggestWords returns at most limit words of a language with a key starting with the prefix,
+	// the most frequent first
+	SuggestWords(languageID int16, prefix string, limit int) []*Word
+}
@@ -49,12 +49,44 @@ type WordRepository interface {
 	// FindWordsByLevelAndTopicsAndLanguages finds words filtered by level, optional topics, and language pair
 	// If topicIDs is nil or empty, returns all words for the level (no topic filter)
 	FindWordsByLevelAndTopicsAndLanguages(ctx context.Context, levelID int64, topicIDs []int64, sourceLanguageID, targetLanguageID int16, limit int) ([]*Word, error)
+	// FindWordsAcrossLevelsAndLanguages finds words of every level, optional topics, and language pair
+	// Words are interleaved by level so that a limited result spans all levels
+	FindWordsAcrossLevelsAndLanguages(ctx context.Context, topicIDs []int64, sourceLanguageID, targetLanguageID int16, limit int) ([]*Word, error)
 	// FindTranslationsForWord finds translation words for a given source word and target language
+	// Translations of all senses of the word are merged
 	FindTranslationsForWord(ctx context.Context, sourceWordID int64, targetLanguageID int16, limit int) ([]*Word, error)
-	// SearchWords searches for words using multiple strategies (lemma, normalized, search_key)
-	SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*Word, error)
-	// CountSearchWords returns the total count of words matching the search query
-	CountSearchWords(ctx context.Context, query string, languageID int16) (int, error)
+	// FindTranslationsForSense finds translation words of a single sense in a target language
+	FindTranslationsForSense(ctx context.Context, senseID int64, targetLanguageID int16, limit int) ([]*Word, error)
+	// FindDistractorWords finds plausible wrong answers for a word: words of the same language sharing
+	// its part of speech or topic, or close to its frequency rank. Synonyms of the word, the excluded
+	// words and words translated into one of the excluded translations are left out.
+	FindDistractorWords(ctx context.Context, wordID int64, excludedIDs, excludedTranslationIDs []int64, limit int) ([]*Word, error)
+	// SearchWords searches for words whose lemma, normalized form or search key contain the query
+	// or are similar to it, ranked by match quality and frequency, narrowed down by the filter
+	SearchWords(ctx context.Context, query string, languageID int16, filter WordSearchFilter, limit, offset int) ([]*Word, error)
+	// CountSearchWords returns the total count of words matching the search query and the filter
+	CountSearchWords(ctx context.Context, query string, languageID int16, filter WordSearchFilter) (int, error)
+	// CountSearchWordsByLevel returns the number of words matching the search query and the filter per level,
+	// ignoring the level filter so that the counts of the other levels are known
+	CountSearchWordsByLevel(ctx context.Context, query string, languageID int16, filter WordSearchFilter) ([]*SearchFacet, error)
+	// CountSearchWordsByTopic returns the number of words matching the search query and the filter per topic,
+	// ignoring the topic filter so that the counts of the other topics are known
+	CountSearchWordsByTopic(ctx context.Context, query string, languageID int16, filter WordSearchFilter) ([]*SearchFacet, error)
+	// ReverseSearchWords searches for words of a language by their meaning: a translation into sourceLanguageID
+	// or a definition of one of their senses contains the query. Each word comes once with its best matching sense.
+	ReverseSearchWords(ctx context.Context, query string, sourceLanguageID, languageID int16, limit, offset int) ([]*ReverseSearchResult, error)
+	// CountReverseSearchWords returns the total count of words matching the reverse search query
+	CountReverseSearchWords(ctx context.Context, query string, sourceLanguageID, languageID int16) (int, error)
+	// ListWords lists the words selected by the filter in its sort order, each with its primary translation
+	ListWords(ctx context.Context, filter WordListFilter, limit, offset int) ([]*WordListEntry, error)
+	// CountListWords returns the total count of words selected by the filter
+	CountListWords(ctx context.Context, filter WordListFilter) (int, error)
+	// SuggestWords returns "did you mean" lemmas close to a query, the closest first
+	SuggestWords(ctx context.Context, query string, languageID int16, limit int) ([]string, error)
+	// FindAllWords returns every word of every language
+	FindAllWords(ctx context.Context) ([]*Word, error)
+	// FindWordIndexVersion returns the current fingerprint of the words
+	FindWordIndexVersion(ctx context.Context) (*WordIndexVersion, error)
 }
 
 // SenseRepository defines operations for sense data access
@@ -65,6 +97,18 @@ type SenseRepository interface {
 	FindSensesByWordIDs(ctx context.Context, wordIDs []int64) (map[int64][]*Sense, error)
 }
 
+// ExampleRepository defines operations for example sentence data access
+type ExampleRepository interface {
+	// FindExamplesBySenseIDs returns example sentences for multiple senses, keyed by sense ID
+	FindExamplesBySenseIDs(ctx context.Context, senseIDs []int64) (map[int64][]*Example, error)
+}
+
+// PronunciationRepository defines operations for pronunciation data access
+type PronunciationRepository interface {
+	// FindPronunciationsByWordIDs returns pronunciations for multiple words, keyed by word ID
+	FindPronunciationsByWordIDs(ctx context.Context, wordIDs []int64) (map[int64][]*Pronunciation, error)
+}
+
 // PartOfSpeechRepository defines operations for part of speech data access
 type PartOfSpeechRepository interface {
 	// FindAllPartsOfSpeech returns all parts of speech
@@ -17,3 +17,66 @@ type Word struct {
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
+
+// Reverse search match kinds
+const (
+	// ReverseMatchTranslation means a translation of the sense matched the query
+	ReverseMatchTranslation = "translation"
+	// ReverseMatchDefinition means the definition of the sense matched the query
+	ReverseMatchDefinition = "definition"
+)
+
+// ReverseSearchResult is a word found by its meaning, with the sense that matched the query
+type ReverseSearchResult struct {
+	Word        *Word  `json:"word"`
+	Sense       *Sense `json:"sense"`
+	MatchedBy   string `json:"matched_by"`   // 'translation' or 'definition'
+	MatchedText string `json:"matched_text"` // Lemma of the matching translation or definition of the sense
+}
+
+// WordIndexVersion fingerprints the words table; indexes built from the table are stale when it changes
+type WordIndexVersion struct {
+	WordCount     int64     `json:"word_count"`
+	MaxWordID     int64     `json:"max_word_id"`
+	LastUpdatedAt time.Time `json:"last_updated_at"`
+}
+
+// WordSearchFilter narrows a word search down, nil or empty fields do not filter
+type WordSearchFilter struct {
+	LevelID        *int64  // A sense of the word has this level
+	TopicIDs       []int64 // The word has one of these topics
+	PartOfSpeechID *int16  // A sense of the word has this part of speech, the same sense as the level
+	HasAudio       *bool   // The word has, or has not, a pronunciation with audio
+	ScriptCode     *string // The word is written in this script: 'Latn', 'Hani', ...
+}
+
+// SearchFacet is the number of words of a search result sharing a level or a topic
+type SearchFacet struct {
+	ID    int64  `json:"id"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Word list sort orders
+const (
+	// WordListSortFrequency lists the most frequent words first
+	WordListSortFrequency = "frequency"
+	// WordListSortAlpha lists the words alphabetically by search key, pinyin for Chinese
+	WordListSortAlpha = "alpha"
+)
+
+// WordListFilter selects the words of a word list, nil or empty fields do not filter
+type WordListFilter struct {
+	LanguageID       int16
+	LevelID          *int64  // A sense of the word has this level
+	TopicIDs         []int64 // The word has one of these topics
+	TargetLanguageID *int16  // Language of the primary translations, none when nil
+	Sort             string  // 'frequency' or 'alpha'
+}
+
+// WordListEntry is a word of a word list with its primary translation into the target language
+type WordListEntry struct {
+	Word        *Word `json:"word"`
+	Translation *Word `json:"translation,omitempty"` // ID, LanguageID, Lemma and Romanization only; nil when untranslated
+}
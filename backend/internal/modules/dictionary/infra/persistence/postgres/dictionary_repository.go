@@ -56,6 +56,20 @@ func (r *DictionaryRepository) SenseRepository() domain.SenseRepository {
 	}
 }
 
+// ExampleRepository returns an ExampleRepository implementation
+func (r *DictionaryRepository) ExampleRepository() domain.ExampleRepository {
+	return &exampleRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// PronunciationRepository returns a PronunciationRepository implementation
+func (r *DictionaryRepository) PronunciationRepository() domain.PronunciationRepository {
+	return &pronunciationRepository{
+		DictionaryRepository: r,
+	}
+}
+
 // PartOfSpeechRepository returns a PartOfSpeechRepository implementation
 func (r *DictionaryRepository) PartOfSpeechRepository() domain.PartOfSpeechRepository {
 	return &partOfSpeechRepository{
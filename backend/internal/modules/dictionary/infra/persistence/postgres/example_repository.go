@@ -0,0 +1,51 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// exampleRepository implements ExampleRepository using sqlc
+type exampleRepository struct {
+	*DictionaryRepository
+}
+
+// FindExamplesBySenseIDs returns example sentences for multiple senses, keyed by sense ID
+func (r *exampleRepository) FindExamplesBySenseIDs(ctx context.Context, senseIDs []int64) (map[int64][]*domain.Example, error) {
+	if len(senseIDs) == 0 {
+		return make(map[int64][]*domain.Example), nil
+	}
+
+	rows, err := r.queries.FindExamplesBySenseIDs(ctx, senseIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindExamplesBySenseIDs")
+	}
+
+	result := make(map[int64][]*domain.Example)
+	for _, row := range rows {
+		var audioURL, source *string
+
+		if row.AudioUrl.Valid {
+			val := row.AudioUrl.String
+			audioURL = &val
+		}
+		if row.Source.Valid {
+			val := row.Source.String
+			source = &val
+		}
+
+		example := &domain.Example{
+			ID:            row.ID,
+			SourceSenseID: row.SourceSenseID,
+			LanguageID:    row.LanguageID,
+			Content:       row.Content,
+			AudioURL:      audioURL,
+			Source:        source,
+		}
+		result[example.SourceSenseID] = append(result[example.SourceSenseID], example)
+	}
+
+	return result, nil
+}
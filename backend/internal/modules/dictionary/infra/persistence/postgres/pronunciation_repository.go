@@ -0,0 +1,59 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// pronunciationRepository implements PronunciationRepository using sqlc
+type pronunciationRepository struct {
+	*DictionaryRepository
+}
+
+// FindPronunciationsByWordIDs returns pronunciations for multiple words, keyed by word ID
+func (r *pronunciationRepository) FindPronunciationsByWordIDs(ctx context.Context, wordIDs []int64) (map[int64][]*domain.Pronunciation, error) {
+	if len(wordIDs) == 0 {
+		return make(map[int64][]*domain.Pronunciation), nil
+	}
+
+	rows, err := r.queries.FindPronunciationsByWordIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindPronunciationsByWordIDs")
+	}
+
+	result := make(map[int64][]*domain.Pronunciation)
+	for _, row := range rows {
+		var dialect, ipa, phonetic, audioURL *string
+
+		if row.Dialect.Valid {
+			val := row.Dialect.String
+			dialect = &val
+		}
+		if row.Ipa.Valid {
+			val := row.Ipa.String
+			ipa = &val
+		}
+		if row.Phonetic.Valid {
+			val := row.Phonetic.String
+			phonetic = &val
+		}
+		if row.AudioUrl.Valid {
+			val := row.AudioUrl.String
+			audioURL = &val
+		}
+
+		pronunciation := &domain.Pronunciation{
+			ID:       row.ID,
+			WordID:   row.WordID,
+			Dialect:  dialect,
+			IPA:      ipa,
+			Phonetic: phonetic,
+			AudioURL: audioURL,
+		}
+		result[pronunciation.WordID] = append(result[pronunciation.WordID], pronunciation)
+	}
+
+	return result, nil
+}
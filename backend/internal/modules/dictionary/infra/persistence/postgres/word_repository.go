@@ -2,11 +2,13 @@ package dictionary
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
 	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	"github.com/english-coach/backend/internal/shared/constants"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 )
 
@@ -160,6 +162,27 @@ func (r *wordRepository) FindWordsByLevelAndTopicsAndLanguages(ctx context.Conte
 	return filteredWords, nil
 }
 
+// FindWordsAcrossLevelsAndLanguages finds words of every level, optional topics, and language pair
+// Words are interleaved by level so that a limited result spans all levels
+func (r *wordRepository) FindWordsAcrossLevelsAndLanguages(ctx context.Context, topicIDs []int64, sourceLanguageID, targetLanguageID int16, limit int) ([]*domain.Word, error) {
+	rows, err := r.queries.FindWordsAcrossLevelsAndLanguages(ctx, db.FindWordsAcrossLevelsAndLanguagesParams{
+		SourceLanguageID: sourceLanguageID,
+		TopicIds:         topicIDs,
+		TargetLanguageID: targetLanguageID,
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindWordsAcrossLevelsAndLanguages")
+	}
+
+	words := make([]*domain.Word, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, r.mapWordRow(row))
+	}
+
+	return words, nil
+}
+
 // FindTranslationsForWord finds translation words for a given source word and target language
 func (r *wordRepository) FindTranslationsForWord(ctx context.Context, sourceWordID int64, targetLanguageID int16, limit int) ([]*domain.Word, error) {
 	rows, err := r.queries.FindTranslationsForWord(ctx, db.FindTranslationsForWordParams{
@@ -179,16 +202,70 @@ func (r *wordRepository) FindTranslationsForWord(ctx context.Context, sourceWord
 	return words, nil
 }
 
-// SearchWords searches for words using multiple strategies (lemma, normalized, search_key)
-func (r *wordRepository) SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*domain.Word, error) {
-	searchPattern := "%" + query + "%"
+// FindTranslationsForSense finds translation words of a single sense in a target language
+func (r *wordRepository) FindTranslationsForSense(ctx context.Context, senseID int64, targetLanguageID int16, limit int) ([]*domain.Word, error) {
+	rows, err := r.queries.FindTranslationsForSense(ctx, db.FindTranslationsForSenseParams{
+		SourceSenseID:    senseID,
+		TargetLanguageID: targetLanguageID,
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindTranslationsForSense")
+	}
+
+	words := make([]*domain.Word, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, r.mapWordRow(row))
+	}
+
+	return words, nil
+}
+
+// FindDistractorWords finds plausible wrong answers for a word, best candidates first
+func (r *wordRepository) FindDistractorWords(ctx context.Context, wordID int64, excludedIDs, excludedTranslationIDs []int64, limit int) ([]*domain.Word, error) {
+	if excludedIDs == nil {
+		excludedIDs = []int64{}
+	}
+	if excludedTranslationIDs == nil {
+		excludedTranslationIDs = []int64{}
+	}
+
+	rows, err := r.queries.FindDistractorWords(ctx, db.FindDistractorWordsParams{
+		WordID:                 wordID,
+		ExcludedIds:            excludedIDs,
+		ExcludedTranslationIds: excludedTranslationIDs,
+		Limit:                  int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindDistractorWords")
+	}
+
+	words := make([]*domain.Word, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, r.mapWordRow(row))
+	}
+
+	return words, nil
+}
+
+// SearchWords searches for words whose lemma, normalized form or search key contain the query
+// or are similar to it, ranked by match quality and frequency, narrowed down by the filter
+func (r *wordRepository) SearchWords(ctx context.Context, query string, languageID int16, filter domain.WordSearchFilter, limit, offset int) ([]*domain.Word, error) {
+	escaped := escapeLikePattern(query)
+	params := mapSearchFilter(filter)
 
 	wordRows, err := r.queries.SearchWords(ctx, db.SearchWordsParams{
-		LanguageID:    languageID,
-		SearchPattern: searchPattern,
-		ExactMatch:    query,
-		Limit:         int32(limit),
-		Offset:        int32(offset),
+		Query:          query,
+		PrefixPattern:  escaped + "%",
+		SearchPattern:  "%" + escaped + "%",
+		LanguageID:     languageID,
+		TopicIds:       params.topicIDs,
+		LevelID:        params.levelID,
+		PartOfSpeechID: params.partOfSpeechID,
+		HasAudio:       params.hasAudio,
+		ScriptCode:     params.scriptCode,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
 	})
 
 	if err != nil {
@@ -203,13 +280,19 @@ func (r *wordRepository) SearchWords(ctx context.Context, query string, language
 	return words, nil
 }
 
-// CountSearchWords returns the total count of words matching the search query
-func (r *wordRepository) CountSearchWords(ctx context.Context, query string, languageID int16) (int, error) {
-	searchPattern := "%" + query + "%"
+// CountSearchWords returns the total count of words matching the search query and the filter
+func (r *wordRepository) CountSearchWords(ctx context.Context, query string, languageID int16, filter domain.WordSearchFilter) (int, error) {
+	params := mapSearchFilter(filter)
 
 	count, err := r.queries.CountSearchWords(ctx, db.CountSearchWordsParams{
-		LanguageID:    languageID,
-		SearchPattern: searchPattern,
+		LanguageID:     languageID,
+		SearchPattern:  "%" + escapeLikePattern(query) + "%",
+		Query:          query,
+		TopicIds:       params.topicIDs,
+		LevelID:        params.levelID,
+		PartOfSpeechID: params.partOfSpeechID,
+		HasAudio:       params.hasAudio,
+		ScriptCode:     params.scriptCode,
 	})
 
 	if err != nil {
@@ -219,6 +302,289 @@ func (r *wordRepository) CountSearchWords(ctx context.Context, query string, lan
 	return int(count), nil
 }
 
+// CountSearchWordsByLevel returns the number of words matching the search query and the filter per level,
+// ignoring the level filter
+func (r *wordRepository) CountSearchWordsByLevel(ctx context.Context, query string, languageID int16, filter domain.WordSearchFilter) ([]*domain.SearchFacet, error) {
+	params := mapSearchFilter(filter)
+
+	rows, err := r.queries.CountSearchWordsByLevel(ctx, db.CountSearchWordsByLevelParams{
+		LanguageID:     languageID,
+		SearchPattern:  "%" + escapeLikePattern(query) + "%",
+		Query:          query,
+		TopicIds:       params.topicIDs,
+		HasAudio:       params.hasAudio,
+		ScriptCode:     params.scriptCode,
+		PartOfSpeechID: params.partOfSpeechID,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "CountSearchWordsByLevel")
+	}
+
+	facets := make([]*domain.SearchFacet, 0, len(rows))
+	for _, row := range rows {
+		facets = append(facets, &domain.SearchFacet{
+			ID:    row.ID,
+			Code:  row.Code,
+			Name:  row.Name,
+			Count: int(row.WordCount),
+		})
+	}
+
+	return facets, nil
+}
+
+// CountSearchWordsByTopic returns the number of words matching the search query and the filter per topic,
+// ignoring the topic filter
+func (r *wordRepository) CountSearchWordsByTopic(ctx context.Context, query string, languageID int16, filter domain.WordSearchFilter) ([]*domain.SearchFacet, error) {
+	params := mapSearchFilter(filter)
+
+	rows, err := r.queries.CountSearchWordsByTopic(ctx, db.CountSearchWordsByTopicParams{
+		LanguageID:     languageID,
+		SearchPattern:  "%" + escapeLikePattern(query) + "%",
+		Query:          query,
+		LevelID:        params.levelID,
+		PartOfSpeechID: params.partOfSpeechID,
+		HasAudio:       params.hasAudio,
+		ScriptCode:     params.scriptCode,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "CountSearchWordsByTopic")
+	}
+
+	facets := make([]*domain.SearchFacet, 0, len(rows))
+	for _, row := range rows {
+		facets = append(facets, &domain.SearchFacet{
+			ID:    row.ID,
+			Code:  row.Code,
+			Name:  row.Name,
+			Count: int(row.WordCount),
+		})
+	}
+
+	return facets, nil
+}
+
+// ListWords lists the words selected by the filter in its sort order, each with its primary translation
+func (r *wordRepository) ListWords(ctx context.Context, filter domain.WordListFilter, limit, offset int) ([]*domain.WordListEntry, error) {
+	var levelID pgtype.Int8
+	if filter.LevelID != nil {
+		levelID = pgtype.Int8{Int64: *filter.LevelID, Valid: true}
+	}
+	var targetLanguageID pgtype.Int2
+	if filter.TargetLanguageID != nil {
+		targetLanguageID = pgtype.Int2{Int16: *filter.TargetLanguageID, Valid: true}
+	}
+
+	rows, err := r.queries.ListWords(ctx, db.ListWordsParams{
+		TargetLanguageID: targetLanguageID,
+		LanguageID:       filter.LanguageID,
+		LevelID:          levelID,
+		TopicIds:         filter.TopicIDs,
+		SortByFrequency:  filter.Sort != domain.WordListSortAlpha,
+		Limit:            int32(limit),
+		Offset:           int32(offset),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "ListWords")
+	}
+
+	entries := make([]*domain.WordListEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := &domain.WordListEntry{
+			Word: r.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			}),
+		}
+		if row.TranslationID.Valid {
+			var romanization *string
+			if row.TranslationRomanization.Valid {
+				romanization = &row.TranslationRomanization.String
+			}
+			entry.Translation = &domain.Word{
+				ID:           row.TranslationID.Int64,
+				LanguageID:   *filter.TargetLanguageID,
+				Lemma:        row.TranslationLemma.String,
+				Romanization: romanization,
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// CountListWords returns the total count of words selected by the filter
+func (r *wordRepository) CountListWords(ctx context.Context, filter domain.WordListFilter) (int, error) {
+	var levelID pgtype.Int8
+	if filter.LevelID != nil {
+		levelID = pgtype.Int8{Int64: *filter.LevelID, Valid: true}
+	}
+
+	count, err := r.queries.CountListWords(ctx, db.CountListWordsParams{
+		LanguageID: filter.LanguageID,
+		LevelID:    levelID,
+		TopicIds:   filter.TopicIDs,
+	})
+	if err != nil {
+		return 0, sharederrors.MapDictionaryRepositoryError(err, "CountListWords")
+	}
+
+	return int(count), nil
+}
+
+// SuggestWords returns "did you mean" lemmas close to a query, the closest first
+func (r *wordRepository) SuggestWords(ctx context.Context, query string, languageID int16, limit int) ([]string, error) {
+	lemmas, err := r.queries.SuggestWords(ctx, db.SuggestWordsParams{
+		Query:         query,
+		LanguageID:    languageID,
+		Limit:         int32(limit),
+		MinSimilarity: constants.DictionarySuggestionMinSimilarity,
+	})
+
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "SuggestWords")
+	}
+
+	return lemmas, nil
+}
+
+// ReverseSearchWords searches for words of a language by their meaning: a translation into sourceLanguageID
+// or a definition of one of their senses contains the query. Each word comes once with its best matching sense.
+func (r *wordRepository) ReverseSearchWords(ctx context.Context, query string, sourceLanguageID, languageID int16, limit, offset int) ([]*domain.ReverseSearchResult, error) {
+	rows, err := r.queries.ReverseSearchWords(ctx, db.ReverseSearchWordsParams{
+		Query:            query,
+		SearchPattern:    "%" + escapeLikePattern(query) + "%",
+		SourceLanguageID: sourceLanguageID,
+		LanguageID:       languageID,
+		Limit:            int32(limit),
+		Offset:           int32(offset),
+	})
+
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "ReverseSearchWords")
+	}
+
+	results := make([]*domain.ReverseSearchResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, &domain.ReverseSearchResult{
+			Word: r.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			}),
+			Sense: &domain.Sense{
+				ID:                   row.SenseID,
+				WordID:               row.ID,
+				SenseOrder:           row.SenseOrder,
+				PartOfSpeechID:       row.PartOfSpeechID,
+				Definition:           row.Definition,
+				DefinitionLanguageID: row.DefinitionLanguageID,
+			},
+			MatchedBy:   row.MatchedBy,
+			MatchedText: row.MatchedText,
+		})
+	}
+
+	return results, nil
+}
+
+// CountReverseSearchWords returns the total count of words matching the reverse search query
+func (r *wordRepository) CountReverseSearchWords(ctx context.Context, query string, sourceLanguageID, languageID int16) (int, error) {
+	count, err := r.queries.CountReverseSearchWords(ctx, db.CountReverseSearchWordsParams{
+		SearchPattern:    "%" + escapeLikePattern(query) + "%",
+		SourceLanguageID: sourceLanguageID,
+		LanguageID:       languageID,
+	})
+
+	if err != nil {
+		return 0, sharederrors.MapDictionaryRepositoryError(err, "CountReverseSearchWords")
+	}
+
+	return int(count), nil
+}
+
+// FindAllWords returns every word of every language
+func (r *wordRepository) FindAllWords(ctx context.Context) ([]*domain.Word, error) {
+	rows, err := r.queries.FindAllWords(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindAllWords")
+	}
+
+	words := make([]*domain.Word, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, r.mapWordRow(row))
+	}
+
+	return words, nil
+}
+
+// FindWordIndexVersion returns the current fingerprint of the words
+func (r *wordRepository) FindWordIndexVersion(ctx context.Context) (*domain.WordIndexVersion, error) {
+	row, err := r.queries.FindWordIndexVersion(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindWordIndexVersion")
+	}
+
+	return &domain.WordIndexVersion{
+		WordCount:     row.WordCount,
+		MaxWordID:     row.MaxWordID,
+		LastUpdatedAt: row.LastUpdatedAt.Time,
+	}, nil
+}
+
+// escapeLikePattern escapes the LIKE wildcards of a user query so that they match literally
+func escapeLikePattern(query string) string {
+	return likeEscaper.Replace(query)
+}
+
+// searchFilterParams is a word search filter as query parameters, NULL where it does not filter
+type searchFilterParams struct {
+	topicIDs       []int64
+	levelID        pgtype.Int8
+	partOfSpeechID pgtype.Int2
+	hasAudio       pgtype.Bool
+	scriptCode     pgtype.Text
+}
+
+// mapSearchFilter maps a word search filter to query parameters
+func mapSearchFilter(filter domain.WordSearchFilter) searchFilterParams {
+	params := searchFilterParams{topicIDs: filter.TopicIDs}
+	if filter.LevelID != nil {
+		params.levelID = pgtype.Int8{Int64: *filter.LevelID, Valid: true}
+	}
+	if filter.PartOfSpeechID != nil {
+		params.partOfSpeechID = pgtype.Int2{Int16: *filter.PartOfSpeechID, Valid: true}
+	}
+	if filter.HasAudio != nil {
+		params.hasAudio = pgtype.Bool{Bool: *filter.HasAudio, Valid: true}
+	}
+	if filter.ScriptCode != nil {
+		params.scriptCode = pgtype.Text{String: *filter.ScriptCode, Valid: true}
+	}
+	return params
+}
+
+// likeEscaper escapes the backslash, the default LIKE escape character, and the wildcards
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // mapWordRow maps sqlc generated row to domain model
 func (r *wordRepository) mapWordRow(row db.Word) *domain.Word {
 	var lemmaNormalized, searchKey, romanization, scriptCode, note *string
@@ -0,0 +1,256 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/textnorm"
+)
+
+// prefixEntry is one key of a word in the index, with the position of the word in suggestion order
+type prefixEntry struct {
+	key  string
+	rank int
+	word *domain.Word
+}
+
+// prefixSnapshot is an immutable build of the index: the keys of each language sorted by key,
+// and the same keys sorted by rank
+type prefixSnapshot struct {
+	entries map[int16][]prefixEntry
+	ranked  map[int16][]prefixEntry
+}
+
+// PrefixIndex is an in-memory index of the words by their keys, see domain.WordPrefixIndex.
+// The keys of a word are its lemma, normalized form and search key, folded by textnorm.Normalize,
+// and its search key without tone numbers, so that "xue" and "xuexi" both find 学习 (xue2xi2).
+// Lookups read an immutable snapshot; a rebuild swaps in a new one.
+type PrefixIndex struct {
+	wordRepo domain.WordRepository
+	logger   logger.ILogger
+	snapshot atomic.Pointer[prefixSnapshot]
+
+	// mu serializes rebuilds, version is the fingerprint of the words of the current snapshot
+	mu      sync.Mutex
+	version *domain.WordIndexVersion
+}
+
+// NewPrefixIndex creates a new empty prefix index over the words of the repository
+func NewPrefixIndex(wordRepo domain.WordRepository, logger logger.ILogger) *PrefixIndex {
+	idx := &PrefixIndex{
+		wordRepo: wordRepo,
+		logger:   logger,
+	}
+	idx.snapshot.Store(newPrefixSnapshot(nil))
+	return idx
+}
+
+// Build loads all words and replaces the index with one built from them
+func (idx *PrefixIndex) Build(ctx context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	return idx.build(ctx)
+}
+
+// Refresh rebuilds the index when the words changed since the last build
+func (idx *PrefixIndex) Refresh(ctx context.Context) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	version, err := idx.wordRepo.FindWordIndexVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if idx.version != nil && *idx.version == *version {
+		return nil
+	}
+
+	return idx.build(ctx)
+}
+
+// Run refreshes the index at every interval until ctx is done.
+// A failed refresh is logged and the current index keeps serving.
+func (idx *PrefixIndex) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := idx.Refresh(ctx); err != nil && ctx.Err() == nil {
+				idx.logger.Error("failed to refresh word prefix index", logger.Error(err))
+			}
+		}
+	}
+}
+
+// build loads the words and swaps in a new snapshot, idx.mu must be held
+func (idx *PrefixIndex) build(ctx context.Context) error {
+	// Read the version first: a change made while loading the words triggers the next refresh
+	version, err := idx.wordRepo.FindWordIndexVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	words, err := idx.wordRepo.FindAllWords(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := newPrefixSnapshot(words)
+	idx.snapshot.Store(snapshot)
+	idx.version = version
+
+	idx.logger.Info("word prefix index built",
+		logger.Int("words_count", len(words)),
+		logger.Int("languages_count", len(snapshot.entries)),
+	)
+
+	return nil
+}
+
+// newPrefixSnapshot builds the index of the words
+func newPrefixSnapshot(words []*domain.Word) *prefixSnapshot {
+	// The rank of a word is its position among all words in suggestion order
+	suggestionOrder := slices.Clone(words)
+	slices.SortFunc(suggestionOrder, compareSuggestions)
+	ranks := make(map[int64]int, len(suggestionOrder))
+	for rank, word := range suggestionOrder {
+		ranks[word.ID] = rank
+	}
+
+	entries := make(map[int16][]prefixEntry)
+	for _, word := range words {
+		for _, key := range wordKeys(word) {
+			entries[word.LanguageID] = append(entries[word.LanguageID], prefixEntry{key: key, rank: ranks[word.ID], word: word})
+		}
+	}
+
+	ranked := make(map[int16][]prefixEntry, len(entries))
+	for languageID, languageEntries := range entries {
+		slices.SortFunc(languageEntries, func(a, b prefixEntry) int {
+			return strings.Compare(a.key, b.key)
+		})
+		ranked[languageID] = slices.Clone(languageEntries)
+		slices.SortStableFunc(ranked[languageID], compareRanks)
+	}
+
+	return &prefixSnapshot{entries: entries, ranked: ranked}
+}
+
+// SuggestWords returns at most limit words of a language with a key starting with the prefix,
+// ordered by frequency rank (unranked words last), then by lemma length and lemma
+func (idx *PrefixIndex) SuggestWords(languageID int16, prefix string, limit int) []*domain.Word {
+	key := textnorm.Normalize(prefix)
+	if key == "" || limit <= 0 {
+		return []*domain.Word{}
+	}
+
+	snapshot := idx.snapshot.Load()
+	entries := snapshot.entries[languageID]
+	start, _ := slices.BinarySearchFunc(entries, key, func(e prefixEntry, target string) int {
+		return strings.Compare(e.key, target)
+	})
+	matches := entries[start:]
+	matches = matches[:sort.Search(len(matches), func(i int) bool {
+		return !strings.HasPrefix(matches[i].key, key)
+	})]
+
+	// A short prefix matches a large part of the language: walking all keys in rank order then
+	// finds limit words after about limit*len(entries)/len(matches) keys, fewer than the matches
+	if len(matches)*len(matches) > limit*len(entries) {
+		return takeSuggestions(snapshot.ranked[languageID], key, limit)
+	}
+
+	matches = slices.Clone(matches)
+	slices.SortFunc(matches, compareRanks)
+	return takeSuggestions(matches, key, limit)
+}
+
+// takeSuggestions returns the first limit distinct words of entries sorted by rank with a key
+// starting with the prefix key, and stops there
+func takeSuggestions(entries []prefixEntry, key string, limit int) []*domain.Word {
+	// A word with several matching keys is suggested once
+	seen := make(map[int64]struct{}, limit)
+	words := make([]*domain.Word, 0, limit)
+	for _, entry := range entries {
+		if len(words) == limit {
+			break
+		}
+		if !strings.HasPrefix(entry.key, key) {
+			continue
+		}
+		if _, ok := seen[entry.word.ID]; ok {
+			continue
+		}
+		seen[entry.word.ID] = struct{}{}
+		words = append(words, entry.word)
+	}
+
+	return words
+}
+
+// compareRanks orders entries by the rank of their word, then by key
+func compareRanks(a, b prefixEntry) int {
+	return cmp.Or(cmp.Compare(a.rank, b.rank), strings.Compare(a.key, b.key))
+}
+
+// compareSuggestions orders words by frequency rank (unranked words last), then by lemma length and lemma
+func compareSuggestions(a, b *domain.Word) int {
+	switch {
+	case a.FrequencyRank != nil && b.FrequencyRank == nil:
+		return -1
+	case a.FrequencyRank == nil && b.FrequencyRank != nil:
+		return 1
+	case a.FrequencyRank != nil && b.FrequencyRank != nil && *a.FrequencyRank != *b.FrequencyRank:
+		return cmp.Compare(*a.FrequencyRank, *b.FrequencyRank)
+	}
+
+	return cmp.Or(
+		cmp.Compare(len([]rune(a.Lemma)), len([]rune(b.Lemma))),
+		strings.Compare(a.Lemma, b.Lemma),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
+
+// wordKeys returns the distinct non-empty keys a word is found by
+func wordKeys(word *domain.Word) []string {
+	candidates := []string{textnorm.Normalize(word.Lemma)}
+	if word.LemmaNormalized != nil {
+		candidates = append(candidates, textnorm.Normalize(*word.LemmaNormalized))
+	}
+	if word.SearchKey != nil {
+		searchKey := textnorm.Normalize(*word.SearchKey)
+		// Pinyin search keys carry tone numbers ("xue2xi2") that learners do not type
+		candidates = append(candidates, searchKey, strings.Map(dropDigit, searchKey))
+	}
+
+	keys := make([]string, 0, len(candidates))
+	for _, key := range candidates {
+		if key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// dropDigit removes digits from a string when used with strings.Map
+func dropDigit(r rune) rune {
+	if unicode.IsDigit(r) {
+		return -1
+	}
+	return r
+}
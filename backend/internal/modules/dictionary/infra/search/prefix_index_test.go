@@ -0,0 +1,106 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+)
+
+func newTestIndex(words []*domain.Word) *PrefixIndex {
+	idx := NewPrefixIndex(nil, nil)
+	idx.snapshot.Store(newPrefixSnapshot(words))
+	return idx
+}
+
+func testWord(id int64, languageID int16, lemma string, searchKey string, frequencyRank int) *domain.Word {
+	word := &domain.Word{ID: id, LanguageID: languageID, Lemma: lemma}
+	if searchKey != "" {
+		word.SearchKey = &searchKey
+	}
+	if frequencyRank > 0 {
+		word.FrequencyRank = &frequencyRank
+	}
+	return word
+}
+
+func lemmas(words []*domain.Word) []string {
+	result := make([]string, len(words))
+	for i, word := range words {
+		result[i] = word.Lemma
+	}
+	return result
+}
+
+func TestPrefixIndexSuggestWords(t *testing.T) {
+	idx := newTestIndex([]*domain.Word{
+		testWord(1, 1, "apple", "", 5),
+		testWord(2, 1, "application", "", 1),
+		testWord(3, 1, "apply", "", 0),
+		testWord(4, 1, "banana", "", 2),
+		testWord(5, 1, "app", "", 10),
+		testWord(6, 2, "学习", "xue2xi2", 3),
+		testWord(7, 2, "学", "xue2", 1),
+	})
+
+	tests := []struct {
+		name       string
+		languageID int16
+		prefix     string
+		limit      int
+		want       []string
+	}{
+		{"all matches by frequency, unranked last", 1, "ap", 10, []string{"application", "apple", "app", "apply"}},
+		{"stops at the limit", 1, "ap", 2, []string{"application", "apple"}},
+		{"case and spaces folded", 1, " APPL", 10, []string{"application", "apple", "apply"}},
+		{"search key without tone numbers", 2, "xue", 10, []string{"学", "学习"}},
+		{"word with several matching keys once", 2, "xuex", 10, []string{"学习"}},
+		{"lemma", 2, "学", 10, []string{"学", "学习"}},
+		{"no match", 1, "cherry", 10, []string{}},
+		{"other language", 3, "ap", 10, []string{}},
+		{"empty prefix", 1, "  ", 10, []string{}},
+		{"zero limit", 1, "ap", 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lemmas(idx.SuggestWords(tt.languageID, tt.prefix, tt.limit))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SuggestWords(%d, %q, %d) = %v, want %v", tt.languageID, tt.prefix, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+// Whether a prefix is served from its key range or from the keys in rank order, the suggestions are
+// the first limit matching words in suggestion order
+func TestPrefixIndexSuggestWordsMatchesFullSort(t *testing.T) {
+	var words []*domain.Word
+	for i := 0; i < 500; i++ {
+		lemma := fmt.Sprintf("%c%c%d", 'a'+i%3, 'a'+i%7, i)
+		words = append(words, testWord(int64(i+1), 1, lemma, "", (i*37)%101))
+	}
+	idx := newTestIndex(words)
+
+	for _, prefix := range []string{"a", "b", "ab", "cg", "ca1", "aa21"} {
+		for _, limit := range []int{1, 5, 20, 1000} {
+			var want []*domain.Word
+			for _, word := range words {
+				if strings.HasPrefix(word.Lemma, prefix) {
+					want = append(want, word)
+				}
+			}
+			slices.SortFunc(want, compareSuggestions)
+			if len(want) > limit {
+				want = want[:limit]
+			}
+
+			got := idx.SuggestWords(1, prefix, limit)
+			if !slices.Equal(lemmas(got), lemmas(want)) {
+				t.Errorf("SuggestWords(1, %q, %d) = %v, want %v", prefix, limit, lemmas(got), lemmas(want))
+			}
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	"time"
+)
+
+// DueReviewResponse represents a word that is due for review
+type DueReviewResponse struct {
+	WordID         int64      `json:"word_id"`
+	Lemma          string     `json:"lemma"`
+	LanguageID     int16      `json:"language_id"`
+	EaseFactor     float64    `json:"ease_factor"`
+	IntervalDays   int        `json:"interval_days"`
+	Repetitions    int        `json:"repetitions"`
+	DueAt          time.Time  `json:"due_at"`
+	LastAnsweredAt *time.Time `json:"last_answered_at,omitempty"`
+}
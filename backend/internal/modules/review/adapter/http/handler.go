@@ -0,0 +1,111 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/review/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/pagination"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles review-related HTTP requests
+type Handler struct {
+	reviewStateRepo domain.ReviewStateRepository
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new review handler
+func NewHandler(
+	reviewStateRepo domain.ReviewStateRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		reviewStateRepo: reviewStateRepo,
+		logger:          logger,
+	}
+}
+
+// ListDueReviews handles GET /api/v1/reviews/due?languageId=...&page=...&pageSize=...
+func (h *Handler) ListDueReviews(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// Parse language ID (optional, all languages if omitted)
+	var languageID *int16
+	if languageIDStr := c.Query("languageId"); languageIDStr != "" {
+		parsed, err := strconv.ParseInt(languageIDStr, 10, 16)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+			return
+		}
+		langID := int16(parsed)
+		languageID = &langID
+	}
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	now := time.Now()
+	reviews, err := h.reviewStateRepo.FindDueReviewsByUserID(ctx, userID, languageID, now, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	total, err := h.reviewStateRepo.CountDueReviewsByUserID(ctx, userID, languageID, now)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]DueReviewResponse, len(reviews))
+	for i, r := range reviews {
+		items[i] = DueReviewResponse{
+			WordID:         r.WordID,
+			Lemma:          r.Lemma,
+			LanguageID:     r.LanguageID,
+			EaseFactor:     r.EaseFactor,
+			IntervalDays:   r.IntervalDays,
+			Repetitions:    r.Repetitions,
+			DueAt:          r.DueAt,
+			LastAnsweredAt: r.LastAnsweredAt,
+		}
+	}
+
+	response.Paginated(c, http.StatusOK, items, paginationParams, total)
+}
+
+// userIDFromContext returns the authenticated user ID set by the auth middleware
+func userIDFromContext(c *gin.Context) (int64, error) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		return 0, sharederrors.NewAppError(
+			sharederrors.CodeUnauthorized,
+			"Người dùng chưa được xác thực",
+		)
+	}
+
+	userIDInt64, ok := userID.(int64)
+	if !ok {
+		return 0, sharederrors.NewAppError(
+			sharederrors.CodeInternalError,
+			"Đã xảy ra lỗi hệ thống",
+		)
+	}
+
+	return userIDInt64, nil
+}
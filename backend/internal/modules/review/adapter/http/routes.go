@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers review-related HTTP routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware gin.HandlerFunc) {
+	// Review routes: /api/v1/reviews/... (protected - requires login)
+	reviewGroup := router.Group("/reviews")
+	reviewGroup.Use(authMiddleware)
+	{
+		reviewGroup.GET("/due", handler.ListDueReviews)
+	}
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ReviewStateRepository defines operations for spaced-repetition state data access
+type ReviewStateRepository interface {
+	// FindReviewState returns the scheduling state of a word for a user (nil if the word has never been scheduled).
+	// Within a unit of work the state stays locked until the transaction ends.
+	FindReviewState(ctx context.Context, userID, wordID int64) (*ReviewState, error)
+	// SaveReviewState creates or updates the scheduling state of a word for a user
+	SaveReviewState(ctx context.Context, state *ReviewState) error
+	// FindDueReviewsByUserID returns words due before the given time with pagination, most overdue first
+	// If languageID is nil, words of all languages are returned
+	FindDueReviewsByUserID(ctx context.Context, userID int64, languageID *int16, dueBefore time.Time, limit, offset int) ([]*DueReview, error)
+	// CountDueReviewsByUserID returns the number of words due before the given time
+	CountDueReviewsByUserID(ctx context.Context, userID int64, languageID *int16, dueBefore time.Time) (int64, error)
+	// FindReviewWordsByLanguages returns scheduled words of a language pair, due words first
+	FindReviewWordsByLanguages(ctx context.Context, userID int64, sourceLanguageID, targetLanguageID int16, dueBefore time.Time, limit int) ([]*ReviewWord, error)
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// ReviewState represents the spaced-repetition scheduling state of a word for a user
+type ReviewState struct {
+	UserID       int64      `json:"user_id"`
+	WordID       int64      `json:"word_id"`
+	EaseFactor   float64    `json:"ease_factor"`
+	IntervalDays int        `json:"interval_days"`
+	Repetitions  int        `json:"repetitions"`
+	DueAt        *time.Time `json:"due_at,omitempty"`
+}
+
+// DueReview represents a word that is due for review
+type DueReview struct {
+	WordID         int64      `json:"word_id"`
+	Lemma          string     `json:"lemma"`
+	LanguageID     int16      `json:"language_id"`
+	EaseFactor     float64    `json:"ease_factor"`
+	IntervalDays   int        `json:"interval_days"`
+	Repetitions    int        `json:"repetitions"`
+	DueAt          time.Time  `json:"due_at"`
+	LastAnsweredAt *time.Time `json:"last_answered_at,omitempty"`
+}
+
+// ReviewWord represents a scheduled word that can be used in a review session
+type ReviewWord struct {
+	WordID int64     `json:"word_id"`
+	DueAt  time.Time `json:"due_at"`
+	IsDue  bool      `json:"is_due"`
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// SM-2 scheduling constants
+const (
+	// DefaultEaseFactor is the ease factor of a word that has never been reviewed
+	DefaultEaseFactor = 2.5
+
+	// MinEaseFactor is the lowest ease factor allowed by SM-2
+	MinEaseFactor = 1.3
+
+	// PassingQuality is the lowest answer quality that counts as a successful recall
+	PassingQuality = 3
+
+	// MaxQuality is the highest answer quality (perfect recall)
+	MaxQuality = 5
+)
+
+// Response time thresholds used to grade correct answers
+const (
+	fastResponseTimeMs = 3000
+	slowResponseTimeMs = 10000
+)
+
+// NewReviewState returns the initial scheduling state of a word that has never been reviewed
+func NewReviewState(userID, wordID int64) *ReviewState {
+	return &ReviewState{
+		UserID:     userID,
+		WordID:     wordID,
+		EaseFactor: DefaultEaseFactor,
+	}
+}
+
+// AnswerQuality grades a game answer on the SM-2 scale (0-5).
+// Wrong answers are a failed recall; correct answers are graded by how quickly they were given.
+func AnswerQuality(isCorrect bool, responseTimeMs *int) int {
+	if !isCorrect {
+		return 1
+	}
+	if responseTimeMs == nil {
+		return 4
+	}
+
+	switch {
+	case *responseTimeMs <= fastResponseTimeMs:
+		return MaxQuality
+	case *responseTimeMs <= slowResponseTimeMs:
+		return 4
+	default:
+		return PassingQuality
+	}
+}
+
+// Schedule applies the SM-2 algorithm for a review of the given quality
+// and sets the next due date relative to reviewedAt
+func (s *ReviewState) Schedule(quality int, reviewedAt time.Time) {
+	if quality < 0 {
+		quality = 0
+	}
+	if quality > MaxQuality {
+		quality = MaxQuality
+	}
+
+	if s.EaseFactor < MinEaseFactor {
+		s.EaseFactor = DefaultEaseFactor
+	}
+
+	if quality < PassingQuality {
+		// Failed recall: start the repetition sequence again
+		s.Repetitions = 0
+		s.IntervalDays = 1
+	} else {
+		switch s.Repetitions {
+		case 0:
+			s.IntervalDays = 1
+		case 1:
+			s.IntervalDays = 6
+		default:
+			s.IntervalDays = int(math.Round(float64(s.IntervalDays) * s.EaseFactor))
+		}
+		if s.IntervalDays < 1 {
+			s.IntervalDays = 1
+		}
+		s.Repetitions++
+	}
+
+	q := float64(MaxQuality - quality)
+	s.EaseFactor += 0.1 - q*(0.08+q*0.02)
+	if s.EaseFactor < MinEaseFactor {
+		s.EaseFactor = MinEaseFactor
+	}
+	s.EaseFactor = math.Round(s.EaseFactor*100) / 100
+
+	dueAt := reviewedAt.AddDate(0, 0, s.IntervalDays)
+	s.DueAt = &dueAt
+}
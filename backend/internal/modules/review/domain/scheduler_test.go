@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestAnswerQuality(t *testing.T) {
+	tests := []struct {
+		name           string
+		isCorrect      bool
+		responseTimeMs *int
+		want           int
+	}{
+		{"wrong", false, intPtr(1000), 1},
+		{"wrong without time", false, nil, 1},
+		{"correct without time", true, nil, 4},
+		{"correct fast", true, intPtr(fastResponseTimeMs), MaxQuality},
+		{"correct in time", true, intPtr(fastResponseTimeMs + 1), 4},
+		{"correct at the slow threshold", true, intPtr(slowResponseTimeMs), 4},
+		{"correct slow", true, intPtr(slowResponseTimeMs + 1), PassingQuality},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AnswerQuality(tt.isCorrect, tt.responseTimeMs); got != tt.want {
+				t.Errorf("AnswerQuality(%v, %v) = %d, want %d", tt.isCorrect, tt.responseTimeMs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewStateSchedule(t *testing.T) {
+	reviewedAt := time.Date(2024, time.March, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		state   ReviewState
+		quality int
+		want    ReviewState
+	}{
+		{
+			name:    "first perfect review",
+			state:   ReviewState{EaseFactor: DefaultEaseFactor},
+			quality: 5,
+			want:    ReviewState{EaseFactor: 2.6, IntervalDays: 1, Repetitions: 1},
+		},
+		{
+			name:    "first passing review lowers the ease",
+			state:   ReviewState{EaseFactor: DefaultEaseFactor},
+			quality: 3,
+			want:    ReviewState{EaseFactor: 2.36, IntervalDays: 1, Repetitions: 1},
+		},
+		{
+			name:    "second review",
+			state:   ReviewState{EaseFactor: 2.6, IntervalDays: 1, Repetitions: 1},
+			quality: 4,
+			want:    ReviewState{EaseFactor: 2.6, IntervalDays: 6, Repetitions: 2},
+		},
+		{
+			name:    "later reviews multiply the interval by the ease",
+			state:   ReviewState{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2},
+			quality: 4,
+			want:    ReviewState{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3},
+		},
+		{
+			name:    "failed recall restarts the repetitions",
+			state:   ReviewState{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3},
+			quality: 1,
+			want:    ReviewState{EaseFactor: 1.96, IntervalDays: 1, Repetitions: 0},
+		},
+		{
+			name:    "ease never drops below the minimum",
+			state:   ReviewState{EaseFactor: MinEaseFactor, IntervalDays: 6, Repetitions: 2},
+			quality: 0,
+			want:    ReviewState{EaseFactor: MinEaseFactor, IntervalDays: 1, Repetitions: 0},
+		},
+		{
+			name:    "unset ease starts from the default",
+			state:   ReviewState{},
+			quality: 4,
+			want:    ReviewState{EaseFactor: DefaultEaseFactor, IntervalDays: 1, Repetitions: 1},
+		},
+		{
+			name:    "quality above the scale counts as perfect",
+			state:   ReviewState{EaseFactor: DefaultEaseFactor},
+			quality: 9,
+			want:    ReviewState{EaseFactor: 2.6, IntervalDays: 1, Repetitions: 1},
+		},
+		{
+			name:    "quality below the scale counts as blackout",
+			state:   ReviewState{EaseFactor: DefaultEaseFactor, IntervalDays: 6, Repetitions: 2},
+			quality: -3,
+			want:    ReviewState{EaseFactor: 1.7, IntervalDays: 1, Repetitions: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			state.Schedule(tt.quality, reviewedAt)
+
+			if state.EaseFactor != tt.want.EaseFactor || state.IntervalDays != tt.want.IntervalDays ||
+				state.Repetitions != tt.want.Repetitions {
+				t.Errorf("Schedule(%d) = ease %v, interval %d, repetitions %d, want ease %v, interval %d, repetitions %d",
+					tt.quality, state.EaseFactor, state.IntervalDays, state.Repetitions,
+					tt.want.EaseFactor, tt.want.IntervalDays, tt.want.Repetitions)
+			}
+
+			wantDueAt := reviewedAt.AddDate(0, 0, tt.want.IntervalDays)
+			if state.DueAt == nil || !state.DueAt.Equal(wantDueAt) {
+				t.Errorf("Schedule(%d) due at %v, want %v", tt.quality, state.DueAt, wantDueAt)
+			}
+		})
+	}
+}
+
+func TestNewReviewState(t *testing.T) {
+	state := NewReviewState(7, 42)
+	if state.UserID != 7 || state.WordID != 42 || state.EaseFactor != DefaultEaseFactor ||
+		state.IntervalDays != 0 || state.Repetitions != 0 || state.DueAt != nil {
+		t.Errorf("NewReviewState(7, 42) = %+v, want a new state with the default ease", state)
+	}
+}
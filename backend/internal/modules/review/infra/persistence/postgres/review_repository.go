@@ -0,0 +1,40 @@
+package review
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/english-coach/backend/internal/modules/review/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/review"
+)
+
+// ReviewRepository implements review repository interfaces using sqlc
+type ReviewRepository struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+// NewReviewRepository creates a new review repository
+func NewReviewRepository(pool *pgxpool.Pool) *ReviewRepository {
+	return &ReviewRepository{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+// queriesFor returns queries bound to the unit-of-work transaction of ctx, if any, or to the pool
+func (r *ReviewRepository) queriesFor(ctx context.Context) *db.Queries {
+	if tx, ok := platformdb.TxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
+// ReviewStateRepository returns a ReviewStateRepository implementation
+func (r *ReviewRepository) ReviewStateRepository() domain.ReviewStateRepository {
+	return &reviewStateRepository{
+		ReviewRepository: r,
+	}
+}
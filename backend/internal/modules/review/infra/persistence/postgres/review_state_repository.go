@@ -0,0 +1,150 @@
+package review
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/review/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/review"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// reviewStateRepository implements ReviewStateRepository using sqlc
+type reviewStateRepository struct {
+	*ReviewRepository
+}
+
+// FindReviewState returns the scheduling state of a word for a user (nil if the word has never been scheduled),
+// locked until the end of the unit-of-work transaction of ctx
+func (r *reviewStateRepository) FindReviewState(ctx context.Context, userID, wordID int64) (*domain.ReviewState, error) {
+	row, err := r.queriesFor(ctx).FindReviewState(ctx, db.FindReviewStateParams{
+		UserID: userID,
+		WordID: wordID,
+	})
+	if err != nil {
+		if sharederrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, sharederrors.MapReviewRepositoryError(err, "FindReviewState")
+	}
+
+	var dueAt *time.Time
+	if row.DueAt.Valid {
+		dueAt = &row.DueAt.Time
+	}
+
+	return &domain.ReviewState{
+		UserID:       row.UserID,
+		WordID:       row.WordID,
+		EaseFactor:   row.EaseFactor,
+		IntervalDays: int(row.IntervalDays),
+		Repetitions:  int(row.Repetitions),
+		DueAt:        dueAt,
+	}, nil
+}
+
+// SaveReviewState creates or updates the scheduling state of a word for a user
+func (r *reviewStateRepository) SaveReviewState(ctx context.Context, state *domain.ReviewState) error {
+	var dueAt pgtype.Timestamp
+	if state.DueAt != nil {
+		dueAt = pgtype.Timestamp{Time: *state.DueAt, Valid: true}
+	}
+
+	err := r.queriesFor(ctx).SaveReviewState(ctx, db.SaveReviewStateParams{
+		UserID:       state.UserID,
+		WordID:       state.WordID,
+		EaseFactor:   state.EaseFactor,
+		IntervalDays: int32(state.IntervalDays),
+		Repetitions:  int32(state.Repetitions),
+		DueAt:        dueAt,
+	})
+	if err != nil {
+		return sharederrors.MapReviewRepositoryError(err, "SaveReviewState")
+	}
+
+	return nil
+}
+
+// FindDueReviewsByUserID returns words due before the given time with pagination, most overdue first
+func (r *reviewStateRepository) FindDueReviewsByUserID(ctx context.Context, userID int64, languageID *int16, dueBefore time.Time, limit, offset int) ([]*domain.DueReview, error) {
+	rows, err := r.queries.FindDueReviewsByUserID(ctx, db.FindDueReviewsByUserIDParams{
+		UserID:     userID,
+		DueBefore:  pgtype.Timestamp{Time: dueBefore, Valid: true},
+		LanguageID: toPgInt2(languageID),
+		Offset:     int32(offset),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapReviewRepositoryError(err, "FindDueReviewsByUserID")
+	}
+
+	reviews := make([]*domain.DueReview, 0, len(rows))
+	for _, row := range rows {
+		var lastAnsweredAt *time.Time
+		if row.LastAnsweredAt.Valid {
+			val := row.LastAnsweredAt.Time
+			lastAnsweredAt = &val
+		}
+
+		reviews = append(reviews, &domain.DueReview{
+			WordID:         row.WordID,
+			Lemma:          row.Lemma,
+			LanguageID:     row.LanguageID,
+			EaseFactor:     row.EaseFactor,
+			IntervalDays:   int(row.IntervalDays),
+			Repetitions:    int(row.Repetitions),
+			DueAt:          row.DueAt.Time,
+			LastAnsweredAt: lastAnsweredAt,
+		})
+	}
+
+	return reviews, nil
+}
+
+// CountDueReviewsByUserID returns the number of words due before the given time
+func (r *reviewStateRepository) CountDueReviewsByUserID(ctx context.Context, userID int64, languageID *int16, dueBefore time.Time) (int64, error) {
+	count, err := r.queries.CountDueReviewsByUserID(ctx, db.CountDueReviewsByUserIDParams{
+		UserID:     userID,
+		DueBefore:  pgtype.Timestamp{Time: dueBefore, Valid: true},
+		LanguageID: toPgInt2(languageID),
+	})
+	if err != nil {
+		return 0, sharederrors.MapReviewRepositoryError(err, "CountDueReviewsByUserID")
+	}
+	return count, nil
+}
+
+// FindReviewWordsByLanguages returns scheduled words of a language pair, due words first
+func (r *reviewStateRepository) FindReviewWordsByLanguages(ctx context.Context, userID int64, sourceLanguageID, targetLanguageID int16, dueBefore time.Time, limit int) ([]*domain.ReviewWord, error) {
+	rows, err := r.queries.FindReviewWordsByLanguages(ctx, db.FindReviewWordsByLanguagesParams{
+		DueBefore:        pgtype.Timestamp{Time: dueBefore, Valid: true},
+		UserID:           userID,
+		SourceLanguageID: sourceLanguageID,
+		TargetLanguageID: targetLanguageID,
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapReviewRepositoryError(err, "FindReviewWordsByLanguages")
+	}
+
+	words := make([]*domain.ReviewWord, 0, len(rows))
+	for _, row := range rows {
+		words = append(words, &domain.ReviewWord{
+			WordID: row.WordID,
+			DueAt:  row.DueAt.Time,
+			IsDue:  row.IsDue,
+		})
+	}
+
+	return words, nil
+}
+
+// toPgInt2 converts an optional int16 to pgtype.Int2
+func toPgInt2(value *int16) pgtype.Int2 {
+	if value == nil {
+		return pgtype.Int2{}
+	}
+	return pgtype.Int2{Int16: *value, Valid: true}
+}
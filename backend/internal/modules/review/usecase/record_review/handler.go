@@ -0,0 +1,75 @@
+package record_review
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/review/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/uow"
+)
+
+// Handler updates the spaced-repetition schedule of a word from an answer
+type Handler struct {
+	reviewStateRepo domain.ReviewStateRepository
+	uow             uow.UnitOfWork
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	reviewStateRepo domain.ReviewStateRepository,
+	uow uow.UnitOfWork,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		reviewStateRepo: reviewStateRepo,
+		uow:             uow,
+		logger:          logger,
+	}
+}
+
+// Execute grades the answer, applies SM-2 to the word's state and saves the next due date.
+// The state is read locked and saved in one unit of work, the one of ctx if there is one.
+func (h *Handler) Execute(ctx context.Context, input RecordReviewInput) (*RecordReviewOutput, error) {
+	var state *domain.ReviewState
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		state, err = h.reviewStateRepo.FindReviewState(ctx, input.UserID, input.WordID)
+		if err != nil {
+			h.logger.Error("failed to find review state",
+				logger.Error(err),
+				logger.Int64("user_id", input.UserID),
+				logger.Int64("word_id", input.WordID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+		if state == nil {
+			state = domain.NewReviewState(input.UserID, input.WordID)
+		}
+
+		quality := domain.AnswerQuality(input.IsCorrect, input.ResponseTimeMs)
+		state.Schedule(quality, input.ReviewedAt)
+
+		if err := h.reviewStateRepo.SaveReviewState(ctx, state); err != nil {
+			h.logger.Error("failed to save review state",
+				logger.Error(err),
+				logger.Int64("user_id", input.UserID),
+				logger.Int64("word_id", input.WordID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	return &RecordReviewOutput{
+		WordID:       state.WordID,
+		EaseFactor:   state.EaseFactor,
+		IntervalDays: state.IntervalDays,
+		Repetitions:  state.Repetitions,
+		DueAt:        *state.DueAt,
+	}, nil
+}
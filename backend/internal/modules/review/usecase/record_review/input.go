@@ -0,0 +1,12 @@
+package record_review
+
+import "time"
+
+// RecordReviewInput represents the input for recording a review of a word use case.
+type RecordReviewInput struct {
+	UserID         int64
+	WordID         int64
+	IsCorrect      bool
+	ResponseTimeMs *int
+	ReviewedAt     time.Time
+}
@@ -0,0 +1,12 @@
+package record_review
+
+import "time"
+
+// RecordReviewOutput represents the scheduling state of a word after a review.
+type RecordReviewOutput struct {
+	WordID       int64
+	EaseFactor   float64
+	IntervalDays int
+	Repetitions  int
+	DueAt        time.Time
+}
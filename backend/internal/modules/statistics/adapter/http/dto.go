@@ -0,0 +1,125 @@
+package http
+
+import (
+	"time"
+)
+
+// GetSessionStatisticsRequest represents the path parameters for session statistics
+type GetSessionStatisticsRequest struct {
+	SessionID int64 `uri:"sessionId" binding:"required"`
+}
+
+// SessionStatisticsResponse represents the statistics of a vocabgame session
+type SessionStatisticsResponse struct {
+	SessionID             int64                        `json:"session_id"`
+	TotalQuestions        int                          `json:"total_questions"`
+	AnsweredQuestions     int                          `json:"answered_questions"`
+	CorrectAnswers        int                          `json:"correct_answers"`
+	WrongAnswers          int                          `json:"wrong_answers"`
+	AccuracyPercentage    float64                      `json:"accuracy_percentage"`
+	DurationSeconds       int                          `json:"duration_seconds"`
+	AverageResponseTimeMs float64                      `json:"average_response_time_ms"`
+	MedianResponseTimeMs  float64                      `json:"median_response_time_ms"`
+	Questions             []QuestionStatisticsResponse `json:"questions"`
+	MissedWords           []MissedWordResponse         `json:"missed_words"`
+}
+
+// QuestionStatisticsResponse represents the outcome of a single question
+type QuestionStatisticsResponse struct {
+	QuestionID       int64   `json:"question_id"`
+	QuestionOrder    int16   `json:"question_order"`
+	SourceWordID     int64   `json:"source_word_id"`
+	SourceWordText   string  `json:"source_word_text"`
+	CorrectWordID    int64   `json:"correct_word_id"`
+	CorrectWordText  string  `json:"correct_word_text"`
+	SelectedOptionID *int64  `json:"selected_option_id,omitempty"`
+	SelectedWordText *string `json:"selected_word_text,omitempty"`
+	Answered         bool    `json:"answered"`
+	IsCorrect        bool    `json:"is_correct"`
+	ResponseTimeMs   *int    `json:"response_time_ms,omitempty"`
+}
+
+// MissedWordResponse represents a word answered incorrectly
+type MissedWordResponse struct {
+	WordID           int64   `json:"word_id"`
+	Lemma            string  `json:"lemma"`
+	CorrectWordID    int64   `json:"correct_word_id"`
+	CorrectWordText  string  `json:"correct_word_text"`
+	SelectedWordText *string `json:"selected_word_text,omitempty"`
+}
+
+// UserStatisticsResponse represents lifetime statistics of the current user
+type UserStatisticsResponse struct {
+	UserID             int64      `json:"user_id"`
+	TotalSessions      int        `json:"total_sessions"`
+	TotalQuestions     int        `json:"total_questions"`
+	TotalCorrect       int        `json:"total_correct"`
+	AccuracyPercentage float64    `json:"accuracy_percentage"`
+	TotalTimeSeconds   int        `json:"total_time_seconds"`
+	LastPlayedAt       *time.Time `json:"last_played_at,omitempty"`
+	CurrentStreak      int        `json:"current_streak"`
+	LongestStreak      int        `json:"longest_streak"`
+	TotalXP            int        `json:"total_xp"`
+}
+
+// UserWordStatisticsResponse represents the progress of the current user on a word
+type UserWordStatisticsResponse struct {
+	WordID             int64      `json:"word_id"`
+	Lemma              string     `json:"lemma"`
+	LanguageID         int16      `json:"language_id"`
+	CorrectCount       int        `json:"correct_count"`
+	WrongCount         int        `json:"wrong_count"`
+	AccuracyPercentage float64    `json:"accuracy_percentage"`
+	Streak             int        `json:"streak"`
+	LastAnsweredAt     *time.Time `json:"last_answered_at,omitempty"`
+}
+
+// UserTopicStatisticsResponse represents the progress of the current user within a topic
+type UserTopicStatisticsResponse struct {
+	TopicID            int64      `json:"topic_id"`
+	TopicCode          string     `json:"topic_code"`
+	TopicName          string     `json:"topic_name"`
+	TotalQuestions     int        `json:"total_questions"`
+	TotalCorrect       int        `json:"total_correct"`
+	AccuracyPercentage float64    `json:"accuracy_percentage"`
+	LastPlayedAt       *time.Time `json:"last_played_at,omitempty"`
+}
+
+// LeaderboardRequest represents the query parameters of a leaderboard
+type LeaderboardRequest struct {
+	Metric           string `form:"metric"` // 'correct_answers' (default), 'accuracy' or 'streak'
+	Period           string `form:"period"` // 'weekly' (default), 'monthly' or 'all_time'
+	SourceLanguageID *int16 `form:"source_language_id"`
+	TargetLanguageID *int16 `form:"target_language_id"`
+	LevelID          *int64 `form:"level_id"`
+}
+
+// LeaderboardEntryResponse represents the ranking of a user on a leaderboard
+type LeaderboardEntryResponse struct {
+	Rank               int     `json:"rank"`
+	UserID             int64   `json:"user_id"`
+	DisplayName        string  `json:"display_name"`
+	AvatarURL          *string `json:"avatar_url,omitempty"`
+	TotalQuestions     int     `json:"total_questions,omitempty"`     // Correct answers and accuracy leaderboards
+	TotalCorrect       int     `json:"total_correct,omitempty"`       // Correct answers and accuracy leaderboards
+	AccuracyPercentage float64 `json:"accuracy_percentage,omitempty"` // Correct answers and accuracy leaderboards
+	CurrentStreak      int     `json:"current_streak,omitempty"`      // Streak leaderboard
+	LongestStreak      int     `json:"longest_streak,omitempty"`      // Streak leaderboard
+}
+
+// ListAchievementsRequest represents the query parameters to list the achievements of the current user
+type ListAchievementsRequest struct {
+	Status string `form:"status"` // 'earned' or 'available'; empty means both
+}
+
+// AchievementResponse represents an achievement with the progress of the current user
+type AchievementResponse struct {
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Kind        string     `json:"kind"`
+	Target      int        `json:"target"`
+	Progress    int        `json:"progress"`
+	Earned      bool       `json:"earned"`
+	EarnedAt    *time.Time `json:"earned_at,omitempty"`
+}
@@ -0,0 +1,337 @@
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	getleaderboard "github.com/english-coach/backend/internal/modules/statistics/usecase/get_leaderboard"
+	getsessionstatistics "github.com/english-coach/backend/internal/modules/statistics/usecase/get_session_statistics"
+	listachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/list_achievements"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/pagination"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles statistics-related HTTP requests
+type Handler struct {
+	getSessionStatisticsUC *getsessionstatistics.Handler
+	getLeaderboardUC       *getleaderboard.Handler
+	listAchievementsUC     *listachievements.Handler
+	userStatisticsRepo     domain.UserStatisticsRepository
+	logger                 logger.ILogger
+}
+
+// NewHandler creates a new statistics handler
+func NewHandler(
+	getSessionStatisticsUC *getsessionstatistics.Handler,
+	getLeaderboardUC *getleaderboard.Handler,
+	listAchievementsUC *listachievements.Handler,
+	userStatisticsRepo domain.UserStatisticsRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		getSessionStatisticsUC: getSessionStatisticsUC,
+		getLeaderboardUC:       getLeaderboardUC,
+		listAchievementsUC:     listAchievementsUC,
+		userStatisticsRepo:     userStatisticsRepo,
+		logger:                 logger,
+	}
+}
+
+// GetSessionStatistics handles GET /api/v1/statistics/sessions/:sessionId
+func (h *Handler) GetSessionStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionStatisticsRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid sessionId"))
+		return
+	}
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	stats, err := h.getSessionStatisticsUC.Execute(ctx, getsessionstatistics.GetSessionStatisticsInput{
+		SessionID: req.SessionID,
+		UserID:    userID,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	questions := make([]QuestionStatisticsResponse, len(stats.Questions))
+	for i, q := range stats.Questions {
+		questions[i] = QuestionStatisticsResponse{
+			QuestionID:       q.QuestionID,
+			QuestionOrder:    q.QuestionOrder,
+			SourceWordID:     q.SourceWordID,
+			SourceWordText:   q.SourceWordText,
+			CorrectWordID:    q.CorrectWordID,
+			CorrectWordText:  q.CorrectWordText,
+			SelectedOptionID: q.SelectedOptionID,
+			SelectedWordText: q.SelectedWordText,
+			Answered:         q.Answered,
+			IsCorrect:        q.IsCorrect,
+			ResponseTimeMs:   q.ResponseTimeMs,
+		}
+	}
+
+	missedWords := make([]MissedWordResponse, len(stats.MissedWords))
+	for i, w := range stats.MissedWords {
+		missedWords[i] = MissedWordResponse{
+			WordID:           w.WordID,
+			Lemma:            w.Lemma,
+			CorrectWordID:    w.CorrectWordID,
+			CorrectWordText:  w.CorrectWordText,
+			SelectedWordText: w.SelectedWordText,
+		}
+	}
+
+	response.Success(c, http.StatusOK, SessionStatisticsResponse{
+		SessionID:             stats.SessionID,
+		TotalQuestions:        stats.TotalQuestions,
+		AnsweredQuestions:     stats.AnsweredQuestions,
+		CorrectAnswers:        stats.CorrectAnswers,
+		WrongAnswers:          stats.WrongAnswers,
+		AccuracyPercentage:    stats.AccuracyPercentage,
+		DurationSeconds:       stats.DurationSeconds,
+		AverageResponseTimeMs: stats.AverageResponseTimeMs,
+		MedianResponseTimeMs:  stats.MedianResponseTimeMs,
+		Questions:             questions,
+		MissedWords:           missedWords,
+	})
+}
+
+// GetMyStatistics handles GET /api/v1/users/me/statistics
+func (h *Handler) GetMyStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	stats, err := h.userStatisticsRepo.FindUserStatisticsByUserID(ctx, userID)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, UserStatisticsResponse{
+		UserID:             stats.UserID,
+		TotalSessions:      stats.TotalSessions,
+		TotalQuestions:     stats.TotalQuestions,
+		TotalCorrect:       stats.TotalCorrect,
+		AccuracyPercentage: accuracyPercentage(stats.TotalCorrect, stats.TotalQuestions),
+		TotalTimeSeconds:   stats.TotalTimeSeconds,
+		LastPlayedAt:       stats.LastPlayedAt,
+		CurrentStreak:      stats.CurrentStreak,
+		LongestStreak:      stats.LongestStreak,
+		TotalXP:            stats.TotalXP,
+	})
+}
+
+// ListMyWordStatistics handles GET /api/v1/users/me/statistics/words
+func (h *Handler) ListMyWordStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	stats, err := h.userStatisticsRepo.FindUserWordStatisticsByUserID(ctx, userID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	total, err := h.userStatisticsRepo.CountUserWordStatisticsByUserID(ctx, userID)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]UserWordStatisticsResponse, len(stats))
+	for i, s := range stats {
+		items[i] = UserWordStatisticsResponse{
+			WordID:             s.WordID,
+			Lemma:              s.Lemma,
+			LanguageID:         s.LanguageID,
+			CorrectCount:       s.CorrectCount,
+			WrongCount:         s.WrongCount,
+			AccuracyPercentage: accuracyPercentage(s.CorrectCount, s.CorrectCount+s.WrongCount),
+			Streak:             s.Streak,
+			LastAnsweredAt:     s.LastAnsweredAt,
+		}
+	}
+
+	response.Paginated(c, http.StatusOK, items, paginationParams, total)
+}
+
+// ListMyTopicStatistics handles GET /api/v1/users/me/statistics/topics
+func (h *Handler) ListMyTopicStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	stats, err := h.userStatisticsRepo.FindUserTopicStatisticsByUserID(ctx, userID)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]UserTopicStatisticsResponse, len(stats))
+	for i, s := range stats {
+		items[i] = UserTopicStatisticsResponse{
+			TopicID:            s.TopicID,
+			TopicCode:          s.TopicCode,
+			TopicName:          s.TopicName,
+			TotalQuestions:     s.TotalQuestions,
+			TotalCorrect:       s.TotalCorrect,
+			AccuracyPercentage: accuracyPercentage(s.TotalCorrect, s.TotalQuestions),
+			LastPlayedAt:       s.LastPlayedAt,
+		}
+	}
+
+	response.Success(c, http.StatusOK, items)
+}
+
+// GetLeaderboard handles GET /api/v1/statistics/leaderboard
+func (h *Handler) GetLeaderboard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req LeaderboardRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails(err.Error()))
+		return
+	}
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	board, err := h.getLeaderboardUC.Execute(ctx, getleaderboard.GetLeaderboardInput{
+		Metric:           req.Metric,
+		Period:           req.Period,
+		SourceLanguageID: req.SourceLanguageID,
+		TargetLanguageID: req.TargetLanguageID,
+		LevelID:          req.LevelID,
+		Limit:            paginationParams.Limit,
+		Offset:           paginationParams.Offset,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]LeaderboardEntryResponse, len(board.Entries))
+	for i, e := range board.Entries {
+		items[i] = LeaderboardEntryResponse{
+			Rank:               e.Rank,
+			UserID:             e.UserID,
+			DisplayName:        e.DisplayName,
+			AvatarURL:          e.AvatarURL,
+			TotalQuestions:     e.TotalQuestions,
+			TotalCorrect:       e.TotalCorrect,
+			AccuracyPercentage: accuracyPercentage(e.TotalCorrect, e.TotalQuestions),
+			CurrentStreak:      e.CurrentStreak,
+			LongestStreak:      e.LongestStreak,
+		}
+	}
+
+	response.Paginated(c, http.StatusOK, items, paginationParams, board.Total)
+}
+
+// ListMyAchievements handles GET /api/v1/users/me/achievements
+func (h *Handler) ListMyAchievements(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := userIDFromContext(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	var req ListAchievementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails(err.Error()))
+		return
+	}
+
+	result, err := h.listAchievementsUC.Execute(ctx, listachievements.ListAchievementsInput{
+		UserID: userID,
+		Status: req.Status,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]AchievementResponse, len(result.Achievements))
+	for i, a := range result.Achievements {
+		items[i] = AchievementResponse{
+			Code:        a.Achievement.Code,
+			Name:        a.Achievement.Name,
+			Description: a.Achievement.Description,
+			Kind:        a.Achievement.Kind,
+			Target:      a.Achievement.Target,
+			Progress:    a.Progress,
+			Earned:      a.EarnedAt != nil,
+			EarnedAt:    a.EarnedAt,
+		}
+	}
+
+	response.Success(c, http.StatusOK, items)
+}
+
+// accuracyPercentage returns correct/total as a percentage rounded to two decimals
+func accuracyPercentage(correct, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(correct)*100/float64(total)*100) / 100
+}
+
+// userIDFromContext returns the authenticated user ID set by the auth middleware
+func userIDFromContext(c *gin.Context) (int64, error) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		return 0, sharederrors.NewAppError(
+			sharederrors.CodeUnauthorized,
+			"Người dùng chưa được xác thực",
+		)
+	}
+
+	userIDInt64, ok := userID.(int64)
+	if !ok {
+		return 0, sharederrors.NewAppError(
+			sharederrors.CodeInternalError,
+			"Đã xảy ra lỗi hệ thống",
+		)
+	}
+
+	return userIDInt64, nil
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers statistics-related HTTP routes
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware gin.HandlerFunc) {
+	// Statistics routes: /api/v1/statistics/... (protected - requires login)
+	statisticsGroup := router.Group("/statistics")
+	statisticsGroup.Use(authMiddleware)
+	{
+		statisticsGroup.GET("/sessions/:sessionId", handler.GetSessionStatistics)
+		statisticsGroup.GET("/leaderboard", handler.GetLeaderboard)
+	}
+
+	// Lifetime statistics of the current user: /api/v1/users/me/statistics/... (protected)
+	myStatisticsGroup := router.Group("/users/me/statistics")
+	myStatisticsGroup.Use(authMiddleware)
+	{
+		myStatisticsGroup.GET("", handler.GetMyStatistics)
+		myStatisticsGroup.GET("/words", handler.ListMyWordStatistics)
+		myStatisticsGroup.GET("/topics", handler.ListMyTopicStatistics)
+	}
+
+	// Achievements of the current user: /api/v1/users/me/achievements (protected)
+	myAchievementsGroup := router.Group("/users/me/achievements")
+	myAchievementsGroup.Use(authMiddleware)
+	{
+		myAchievementsGroup.GET("", handler.ListMyAchievements)
+	}
+}
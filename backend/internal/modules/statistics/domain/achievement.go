@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// Achievement kinds, each one is earned on a different measure of progress
+const (
+	// AchievementKindWordsMastered counts the words answered correctly MasteredWordStreak times in a row
+	AchievementKindWordsMastered = "words_mastered"
+	// AchievementKindLevelSessions counts the completed sessions of a level
+	AchievementKindLevelSessions = "level_sessions"
+	// AchievementKindStreak is the longest run of consecutive days played
+	AchievementKindStreak = "streak"
+)
+
+// MasteredWordStreak is the number of correct answers in a row after which a word is mastered
+const MasteredWordStreak = 3
+
+// Achievement describes an achievement users can earn
+type Achievement struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Kind        string `json:"kind"`
+	Target      int    `json:"target"`               // Progress needed to earn it
+	LevelCode   string `json:"level_code,omitempty"` // Level sessions only
+}
+
+// Achievements is the catalog of achievements, in display order.
+// Earned achievements are stored by code, so codes must never change.
+var Achievements = []Achievement{
+	{
+		Code:        "words_mastered_100",
+		Name:        "100 words mastered",
+		Description: "Answer 100 different words correctly 3 times in a row",
+		Kind:        AchievementKindWordsMastered,
+		Target:      100,
+	},
+	{
+		Code:        "first_hsk3_session",
+		Name:        "First HSK3 session",
+		Description: "Complete a game session of level HSK3",
+		Kind:        AchievementKindLevelSessions,
+		Target:      1,
+		LevelCode:   "HSK3",
+	},
+	{
+		Code:        "streak_7_days",
+		Name:        "7-day streak",
+		Description: "Play 7 days in a row",
+		Kind:        AchievementKindStreak,
+		Target:      7,
+	},
+}
+
+// AchievementProgress gathers the measures achievements are earned on
+type AchievementProgress struct {
+	WordsMastered int
+	LongestStreak int
+	LevelSessions map[string]int // Completed sessions by level code
+}
+
+// Of returns the progress of the user toward an achievement, capped at its target
+func (p *AchievementProgress) Of(achievement Achievement) int {
+	var progress int
+	switch achievement.Kind {
+	case AchievementKindWordsMastered:
+		progress = p.WordsMastered
+	case AchievementKindLevelSessions:
+		progress = p.LevelSessions[achievement.LevelCode]
+	case AchievementKindStreak:
+		progress = p.LongestStreak
+	}
+	return min(progress, achievement.Target)
+}
+
+// Reached checks if the progress of the user is enough to earn an achievement
+func (p *AchievementProgress) Reached(achievement Achievement) bool {
+	return p.Of(achievement) >= achievement.Target
+}
+
+// UserAchievement represents an achievement earned by a user
+type UserAchievement struct {
+	UserID          int64     `json:"user_id"`
+	AchievementCode string    `json:"achievement_code"`
+	EarnedAt        time.Time `json:"earned_at"`
+}
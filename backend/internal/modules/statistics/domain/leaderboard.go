@@ -0,0 +1,120 @@
+package domain
+
+import "time"
+
+// Leaderboard periods, answers are aggregated for each of them
+const (
+	// LeaderboardPeriodWeekly covers the current week, starting on Monday
+	LeaderboardPeriodWeekly = "weekly"
+	// LeaderboardPeriodMonthly covers the current calendar month
+	LeaderboardPeriodMonthly = "monthly"
+	// LeaderboardPeriodAllTime covers every answer since the user started playing
+	LeaderboardPeriodAllTime = "all_time"
+)
+
+// LeaderboardPeriods lists the periods answers are aggregated for
+var LeaderboardPeriods = []string{LeaderboardPeriodWeekly, LeaderboardPeriodMonthly, LeaderboardPeriodAllTime}
+
+// Leaderboard metrics users are ranked by
+const (
+	// LeaderboardMetricCorrectAnswers ranks users by the number of correct answers
+	LeaderboardMetricCorrectAnswers = "correct_answers"
+	// LeaderboardMetricAccuracy ranks users by accuracy, above a minimum number of answers
+	LeaderboardMetricAccuracy = "accuracy"
+	// LeaderboardMetricStreak ranks users by their current daily streak
+	LeaderboardMetricStreak = "streak"
+)
+
+// allTimePeriodStart is the period start of the all-time aggregates
+var allTimePeriodStart = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// IsValidLeaderboardPeriod checks if a leaderboard period is valid
+func IsValidLeaderboardPeriod(period string) bool {
+	switch period {
+	case LeaderboardPeriodWeekly, LeaderboardPeriodMonthly, LeaderboardPeriodAllTime:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidLeaderboardMetric checks if a leaderboard metric is valid
+func IsValidLeaderboardMetric(metric string) bool {
+	switch metric {
+	case LeaderboardMetricCorrectAnswers, LeaderboardMetricAccuracy, LeaderboardMetricStreak:
+		return true
+	default:
+		return false
+	}
+}
+
+// StatisticsDay returns the day an activity at the given time counts for in period aggregates.
+// Days are UTC days so that periods are the same for every user.
+func StatisticsDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// PeriodStart returns the first day of the period containing the given time
+func PeriodStart(period string, t time.Time) time.Time {
+	day := StatisticsDay(t)
+	switch period {
+	case LeaderboardPeriodWeekly:
+		// Weekday counts from Sunday, weeks start on Monday
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case LeaderboardPeriodMonthly:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return allTimePeriodStart
+	}
+}
+
+// UserLocation returns the location of a user time zone, UTC when it is not set or unknown
+func UserLocation(timezone *string) *time.Location {
+	if timezone == nil || *timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(*timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// StreakDay returns the day an activity at the given time counts for in streaks.
+// Streaks follow the calendar of the user, so the day is taken in the user's location
+// and returned as a UTC date.
+func StreakDay(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// StreakActiveSince returns the earliest last day played for which a streak is still alive at the given time:
+// a streak continues as long as the user played yesterday or today in their location
+func StreakActiveSince(now time.Time, loc *time.Location) time.Time {
+	return StreakDay(now, loc).AddDate(0, 0, -1)
+}
+
+// LeaderboardQuery selects a leaderboard and its filters
+type LeaderboardQuery struct {
+	Metric           string
+	Period           string
+	PeriodStart      time.Time
+	SourceLanguageID *int16    // nil means every source language
+	TargetLanguageID *int16    // nil means every target language
+	LevelID          *int64    // nil means every level, 0 means sessions without a level
+	MinQuestions     int       // Answers needed in the period to be ranked
+	Now              time.Time // Streak only, streaks still alive at this time in each user's time zone are ranked
+}
+
+// LeaderboardEntry represents the ranking of a user on a leaderboard
+type LeaderboardEntry struct {
+	Rank           int     `json:"rank"` // Users with the same value share a rank
+	UserID         int64   `json:"user_id"`
+	DisplayName    string  `json:"display_name"`
+	AvatarURL      *string `json:"avatar_url,omitempty"`
+	TotalQuestions int     `json:"total_questions"` // Answers in the period, not set for streaks
+	TotalCorrect   int     `json:"total_correct"`   // Correct answers in the period, not set for streaks
+	CurrentStreak  int     `json:"current_streak"`  // Streak only
+	LongestStreak  int     `json:"longest_streak"`  // Streak only
+}
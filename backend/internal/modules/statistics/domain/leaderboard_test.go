@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func TestUserLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone *string
+		want     string
+	}{
+		{"not set", nil, "UTC"},
+		{"empty", stringPtr(""), "UTC"},
+		{"unknown", stringPtr("Mars/Olympus_Mons"), "UTC"},
+		{"known", stringPtr("Asia/Ho_Chi_Minh"), "Asia/Ho_Chi_Minh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UserLocation(tt.timezone).String(); got != tt.want {
+				t.Errorf("UserLocation(%v) = %s, want %s", tt.timezone, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreakDay(t *testing.T) {
+	hoChiMinh := UserLocation(stringPtr("Asia/Ho_Chi_Minh"))
+	newYork := UserLocation(stringPtr("America/New_York"))
+
+	tests := []struct {
+		name string
+		t    time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{"UTC", time.Date(2024, time.March, 10, 23, 30, 0, 0, time.UTC), time.UTC, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"late UTC evening is the next day east of UTC", time.Date(2024, time.March, 10, 23, 30, 0, 0, time.UTC), hoChiMinh, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{"early UTC morning is the previous day west of UTC", time.Date(2024, time.March, 10, 3, 30, 0, 0, time.UTC), newYork, time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		{"rolls over at local midnight", time.Date(2024, time.March, 10, 17, 0, 0, 0, time.UTC), hoChiMinh, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{"just before local midnight", time.Date(2024, time.March, 10, 16, 59, 59, 0, time.UTC), hoChiMinh, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"across a year", time.Date(2023, time.December, 31, 20, 0, 0, 0, time.UTC), hoChiMinh, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StreakDay(tt.t, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("StreakDay(%v, %s) = %v, want %v", tt.t, tt.loc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreakActiveSince(t *testing.T) {
+	hoChiMinh := UserLocation(stringPtr("Asia/Ho_Chi_Minh"))
+
+	tests := []struct {
+		name string
+		now  time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{"yesterday in UTC", time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC), time.UTC, time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		{"yesterday in the user's location", time.Date(2024, time.March, 10, 18, 0, 0, 0, time.UTC), hoChiMinh, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"across a month", time.Date(2024, time.March, 1, 1, 0, 0, 0, time.UTC), time.UTC, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StreakActiveSince(tt.now, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("StreakActiveSince(%v, %s) = %v, want %v", tt.now, tt.loc, got, tt.want)
+			}
+		})
+	}
+}
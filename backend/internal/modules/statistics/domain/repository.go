@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+)
+
+// UserStatisticsRepository defines operations for user statistics data access
+type UserStatisticsRepository interface {
+	// RecordActivity updates user, word and topic aggregates in one transaction
+	RecordActivity(ctx context.Context, activity Activity) error
+	// FindUserStatisticsByUserID returns lifetime statistics of a user (zero values if the user has not played yet)
+	FindUserStatisticsByUserID(ctx context.Context, userID int64) (*UserStatistics, error)
+	// FindUserWordStatisticsByUserID returns per-word statistics of a user with pagination
+	FindUserWordStatisticsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*UserWordStatistics, error)
+	// CountUserWordStatisticsByUserID returns the number of words a user has answered
+	CountUserWordStatisticsByUserID(ctx context.Context, userID int64) (int64, error)
+	// FindUserTopicStatisticsByUserID returns per-topic statistics of a user
+	FindUserTopicStatisticsByUserID(ctx context.Context, userID int64) ([]*UserTopicStatistics, error)
+}
+
+// LeaderboardRepository defines read operations on the cross-user leaderboards
+type LeaderboardRepository interface {
+	// FindLeaderboard returns a page of the leaderboard selected by the query
+	FindLeaderboard(ctx context.Context, query LeaderboardQuery, limit, offset int) ([]*LeaderboardEntry, error)
+	// CountLeaderboard returns the number of users ranked on the leaderboard selected by the query
+	CountLeaderboard(ctx context.Context, query LeaderboardQuery) (int64, error)
+}
+
+// AchievementRepository defines operations for achievement data access
+type AchievementRepository interface {
+	// FindAchievementProgress returns the measures achievements of a user are earned on
+	FindAchievementProgress(ctx context.Context, userID int64) (*AchievementProgress, error)
+	// FindUserAchievementsByUserID returns the achievements earned by a user, oldest first
+	FindUserAchievementsByUserID(ctx context.Context, userID int64) ([]*UserAchievement, error)
+	// CreateUserAchievement stores an earned achievement and reports false if the user already had it
+	CreateUserAchievement(ctx context.Context, achievement *UserAchievement) (bool, error)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// UserStatistics represents lifetime game statistics of a user
+type UserStatistics struct {
+	UserID           int64      `json:"user_id"`
+	TotalSessions    int        `json:"total_sessions"`
+	TotalQuestions   int        `json:"total_questions"`
+	TotalCorrect     int        `json:"total_correct"`
+	TotalTimeSeconds int        `json:"total_time_seconds"`
+	LastPlayedAt     *time.Time `json:"last_played_at,omitempty"`
+	CurrentStreak    int        `json:"current_streak"` // Consecutive days played in the user's time zone, 0 once a day has been missed
+	LongestStreak    int        `json:"longest_streak"`
+	TotalXP          int        `json:"total_xp"`
+}
+
+// UserWordStatistics represents how well a user knows a single word
+type UserWordStatistics struct {
+	UserID         int64      `json:"user_id"`
+	WordID         int64      `json:"word_id"`
+	Lemma          string     `json:"lemma"`
+	LanguageID     int16      `json:"language_id"`
+	CorrectCount   int        `json:"correct_count"`
+	WrongCount     int        `json:"wrong_count"`
+	Streak         int        `json:"streak"`
+	LastAnsweredAt *time.Time `json:"last_answered_at,omitempty"`
+}
+
+// UserTopicStatistics represents the progress of a user within a topic
+type UserTopicStatistics struct {
+	UserID         int64      `json:"user_id"`
+	TopicID        int64      `json:"topic_id"`
+	TopicCode      string     `json:"topic_code"`
+	TopicName      string     `json:"topic_name"`
+	TotalQuestions int        `json:"total_questions"`
+	TotalCorrect   int        `json:"total_correct"`
+	LastPlayedAt   *time.Time `json:"last_played_at,omitempty"`
+}
+
+// AnswerActivity describes an answered game question to be aggregated
+type AnswerActivity struct {
+	WordID           int64
+	IsCorrect        bool
+	AnsweredAt       time.Time
+	SourceLanguageID int16 // Language pair of the session, for leaderboards
+	TargetLanguageID int16
+	LevelID          *int64 // Level of the session, nil when it has none
+	XP               int    // Experience points earned by the answer
+}
+
+// SessionActivity describes a completed game session to be aggregated
+type SessionActivity struct {
+	SessionID       int64
+	DurationSeconds int
+	EndedAt         time.Time
+}
+
+// Activity groups the aggregates to update for a user in a single transaction.
+// Either part may be nil, e.g. a manual completion has no answer.
+type Activity struct {
+	UserID           int64
+	Answer           *AnswerActivity
+	CompletedSession *SessionActivity
+}
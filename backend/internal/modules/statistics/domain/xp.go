@@ -0,0 +1,38 @@
+package domain
+
+// Experience points constants
+const (
+	// BaseAnswerXP is the experience earned by a correct answer at the easiest level
+	BaseAnswerXP = 10
+
+	// defaultSpeedWindowMs is the response time under which a correct answer earns a speed bonus,
+	// for questions without a time limit
+	defaultSpeedWindowMs = 10000
+)
+
+// AnswerXP returns the experience points earned by an answer.
+// Correct answers earn BaseAnswerXP weighted by the difficulty order of the level (1 for the easiest
+// or unknown levels), plus a speed bonus of up to half of it that shrinks linearly to nothing at the
+// time limit of the question. The response time must be measured by the server, a client could claim
+// any; a missing one earns no bonus. Wrong answers earn nothing.
+func AnswerXP(isCorrect bool, difficultyOrder int, responseTimeMs, timeLimitMs *int) int {
+	if !isCorrect {
+		return 0
+	}
+	if difficultyOrder < 1 {
+		difficultyOrder = 1
+	}
+	xp := BaseAnswerXP * difficultyOrder
+	if responseTimeMs == nil || *responseTimeMs < 0 {
+		return xp
+	}
+
+	window := defaultSpeedWindowMs
+	if timeLimitMs != nil && *timeLimitMs > 0 {
+		window = *timeLimitMs
+	}
+	if *responseTimeMs >= window {
+		return xp
+	}
+	return xp + xp*(window-*responseTimeMs)/(2*window)
+}
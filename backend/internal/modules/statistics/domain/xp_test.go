@@ -0,0 +1,40 @@
+package domain
+
+import "testing"
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestAnswerXP(t *testing.T) {
+	tests := []struct {
+		name            string
+		isCorrect       bool
+		difficultyOrder int
+		responseTimeMs  *int
+		timeLimitMs     *int
+		want            int
+	}{
+		{"wrong earns nothing", false, 3, intPtr(0), nil, 0},
+		{"unknown level counts as the easiest", true, 0, nil, nil, BaseAnswerXP},
+		{"weighted by difficulty without response time", true, 3, nil, nil, 3 * BaseAnswerXP},
+		{"negative response time earns no bonus", true, 3, intPtr(-500), nil, 3 * BaseAnswerXP},
+		{"instant answer earns the full bonus", true, 1, intPtr(0), nil, 15},
+		{"bonus shrinks with the response time", true, 2, intPtr(5000), nil, 25},
+		{"no bonus at the end of the window", true, 1, intPtr(defaultSpeedWindowMs), nil, BaseAnswerXP},
+		{"no bonus after the window", true, 1, intPtr(defaultSpeedWindowMs + 1), nil, BaseAnswerXP},
+		{"window is the time limit of the question", true, 1, intPtr(5000), intPtr(20000), 13},
+		{"slow answer within a long time limit", true, 1, intPtr(15000), intPtr(20000), 11},
+		{"zero time limit uses the default window", true, 1, intPtr(5000), intPtr(0), 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AnswerXP(tt.isCorrect, tt.difficultyOrder, tt.responseTimeMs, tt.timeLimitMs)
+			if got != tt.want {
+				t.Errorf("AnswerXP(%v, %d, %v, %v) = %d, want %d",
+					tt.isCorrect, tt.difficultyOrder, tt.responseTimeMs, tt.timeLimitMs, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/statistics"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// achievementRepository implements AchievementRepository using sqlc
+type achievementRepository struct {
+	*StatisticsRepository
+}
+
+// FindAchievementProgress returns the measures achievements of a user are earned on
+func (r *achievementRepository) FindAchievementProgress(ctx context.Context, userID int64) (*domain.AchievementProgress, error) {
+	progress := &domain.AchievementProgress{
+		LevelSessions: make(map[string]int),
+	}
+
+	mastered, err := r.queries.CountMasteredWords(ctx, db.CountMasteredWordsParams{
+		UserID:    userID,
+		MinStreak: domain.MasteredWordStreak,
+	})
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindAchievementProgress")
+	}
+	progress.WordsMastered = int(mastered)
+
+	stats, err := r.queries.FindUserStatisticsByUserID(ctx, userID)
+	if err != nil && !sharederrors.IsNotFound(err) {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindAchievementProgress")
+	}
+	progress.LongestStreak = int(stats.LongestStreak.Int32)
+
+	rows, err := r.queries.CountCompletedSessionsByLevel(ctx, userID)
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindAchievementProgress")
+	}
+	for _, row := range rows {
+		progress.LevelSessions[row.LevelCode] = int(row.CompletedSessions)
+	}
+
+	return progress, nil
+}
+
+// FindUserAchievementsByUserID returns the achievements earned by a user, oldest first
+func (r *achievementRepository) FindUserAchievementsByUserID(ctx context.Context, userID int64) ([]*domain.UserAchievement, error) {
+	rows, err := r.queries.FindUserAchievementsByUserID(ctx, userID)
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindUserAchievementsByUserID")
+	}
+
+	achievements := make([]*domain.UserAchievement, 0, len(rows))
+	for _, row := range rows {
+		achievements = append(achievements, &domain.UserAchievement{
+			UserID:          row.UserID,
+			AchievementCode: row.AchievementCode,
+			EarnedAt:        row.EarnedAt.Time,
+		})
+	}
+
+	return achievements, nil
+}
+
+// CreateUserAchievement stores an earned achievement and reports false if the user already had it
+func (r *achievementRepository) CreateUserAchievement(ctx context.Context, achievement *domain.UserAchievement) (bool, error) {
+	created, err := r.queries.CreateUserAchievement(ctx, db.CreateUserAchievementParams{
+		UserID:          achievement.UserID,
+		AchievementCode: achievement.AchievementCode,
+		EarnedAt:        pgtype.Timestamp{Time: achievement.EarnedAt, Valid: true},
+	})
+	if err != nil {
+		return false, sharederrors.MapStatisticsRepositoryError(err, "CreateUserAchievement")
+	}
+	return created > 0, nil
+}
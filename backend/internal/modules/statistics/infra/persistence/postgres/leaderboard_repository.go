@@ -0,0 +1,172 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/statistics"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// leaderboardRepository implements LeaderboardRepository using sqlc.
+// Leaderboards read the period aggregates, never the answers themselves.
+type leaderboardRepository struct {
+	*StatisticsRepository
+}
+
+// leaderboardFilters holds the optional filters of a query as nullable query arguments
+type leaderboardFilters struct {
+	periodStart      pgtype.Date
+	sourceLanguageID pgtype.Int2
+	targetLanguageID pgtype.Int2
+	levelID          pgtype.Int8
+}
+
+// filtersOf converts the filters of a query to query arguments
+func filtersOf(query domain.LeaderboardQuery) leaderboardFilters {
+	f := leaderboardFilters{
+		periodStart: pgtype.Date{Time: query.PeriodStart, Valid: true},
+	}
+	if query.SourceLanguageID != nil {
+		f.sourceLanguageID = pgtype.Int2{Int16: *query.SourceLanguageID, Valid: true}
+	}
+	if query.TargetLanguageID != nil {
+		f.targetLanguageID = pgtype.Int2{Int16: *query.TargetLanguageID, Valid: true}
+	}
+	if query.LevelID != nil {
+		f.levelID = pgtype.Int8{Int64: *query.LevelID, Valid: true}
+	}
+	return f
+}
+
+// FindLeaderboard returns a page of the leaderboard selected by the query
+func (r *leaderboardRepository) FindLeaderboard(ctx context.Context, query domain.LeaderboardQuery, limit, offset int) ([]*domain.LeaderboardEntry, error) {
+	f := filtersOf(query)
+
+	switch query.Metric {
+	case domain.LeaderboardMetricStreak:
+		rows, err := r.queries.FindStreakLeaderboard(ctx, db.FindStreakLeaderboardParams{
+			Now:              pgtype.Timestamptz{Time: query.Now, Valid: true},
+			PeriodType:       query.Period,
+			PeriodStart:      f.periodStart,
+			SourceLanguageID: f.sourceLanguageID,
+			TargetLanguageID: f.targetLanguageID,
+			LevelID:          f.levelID,
+			Offset:           int32(offset),
+			Limit:            int32(limit),
+		})
+		if err != nil {
+			return nil, sharederrors.MapStatisticsRepositoryError(err, "FindLeaderboard")
+		}
+
+		entries := make([]*domain.LeaderboardEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, &domain.LeaderboardEntry{
+				Rank:          int(row.Rank),
+				UserID:        row.UserID,
+				DisplayName:   row.DisplayName,
+				AvatarURL:     textPtr(row.AvatarUrl),
+				CurrentStreak: int(row.CurrentStreak),
+				LongestStreak: int(row.LongestStreak),
+			})
+		}
+		return entries, nil
+
+	case domain.LeaderboardMetricAccuracy:
+		rows, err := r.queries.FindAccuracyLeaderboard(ctx, db.FindAccuracyLeaderboardParams{
+			PeriodType:       query.Period,
+			PeriodStart:      f.periodStart,
+			SourceLanguageID: f.sourceLanguageID,
+			TargetLanguageID: f.targetLanguageID,
+			LevelID:          f.levelID,
+			MinQuestions:     int32(query.MinQuestions),
+			Offset:           int32(offset),
+			Limit:            int32(limit),
+		})
+		if err != nil {
+			return nil, sharederrors.MapStatisticsRepositoryError(err, "FindLeaderboard")
+		}
+
+		entries := make([]*domain.LeaderboardEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, &domain.LeaderboardEntry{
+				Rank:           int(row.Rank),
+				UserID:         row.UserID,
+				DisplayName:    row.DisplayName,
+				AvatarURL:      textPtr(row.AvatarUrl),
+				TotalQuestions: int(row.TotalQuestions),
+				TotalCorrect:   int(row.TotalCorrect),
+			})
+		}
+		return entries, nil
+
+	default:
+		rows, err := r.queries.FindCorrectAnswersLeaderboard(ctx, db.FindCorrectAnswersLeaderboardParams{
+			PeriodType:       query.Period,
+			PeriodStart:      f.periodStart,
+			SourceLanguageID: f.sourceLanguageID,
+			TargetLanguageID: f.targetLanguageID,
+			LevelID:          f.levelID,
+			Offset:           int32(offset),
+			Limit:            int32(limit),
+		})
+		if err != nil {
+			return nil, sharederrors.MapStatisticsRepositoryError(err, "FindLeaderboard")
+		}
+
+		entries := make([]*domain.LeaderboardEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, &domain.LeaderboardEntry{
+				Rank:           int(row.Rank),
+				UserID:         row.UserID,
+				DisplayName:    row.DisplayName,
+				AvatarURL:      textPtr(row.AvatarUrl),
+				TotalQuestions: int(row.TotalQuestions),
+				TotalCorrect:   int(row.TotalCorrect),
+			})
+		}
+		return entries, nil
+	}
+}
+
+// CountLeaderboard returns the number of users ranked on the leaderboard selected by the query
+func (r *leaderboardRepository) CountLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (int64, error) {
+	f := filtersOf(query)
+
+	var count int64
+	var err error
+	if query.Metric == domain.LeaderboardMetricStreak {
+		count, err = r.queries.CountStreakLeaderboardUsers(ctx, db.CountStreakLeaderboardUsersParams{
+			Now:              pgtype.Timestamptz{Time: query.Now, Valid: true},
+			PeriodType:       query.Period,
+			PeriodStart:      f.periodStart,
+			SourceLanguageID: f.sourceLanguageID,
+			TargetLanguageID: f.targetLanguageID,
+			LevelID:          f.levelID,
+		})
+	} else {
+		count, err = r.queries.CountPeriodLeaderboardUsers(ctx, db.CountPeriodLeaderboardUsersParams{
+			PeriodType:       query.Period,
+			PeriodStart:      f.periodStart,
+			SourceLanguageID: f.sourceLanguageID,
+			TargetLanguageID: f.targetLanguageID,
+			LevelID:          f.levelID,
+			MinQuestions:     int32(query.MinQuestions),
+		})
+	}
+	if err != nil {
+		return 0, sharederrors.MapStatisticsRepositoryError(err, "CountLeaderboard")
+	}
+	return count, nil
+}
+
+// textPtr converts a nullable text column to a string pointer
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	val := t.String
+	return &val
+}
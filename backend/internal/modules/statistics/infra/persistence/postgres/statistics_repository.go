@@ -0,0 +1,54 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/statistics"
+)
+
+// StatisticsRepository implements statistics repository interfaces using sqlc
+type StatisticsRepository struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+// NewStatisticsRepository creates a new statistics repository
+func NewStatisticsRepository(pool *pgxpool.Pool) *StatisticsRepository {
+	return &StatisticsRepository{
+		pool:    pool,
+		queries: db.New(pool),
+	}
+}
+
+// queriesFor returns queries bound to the unit-of-work transaction of ctx, if any, or to the pool
+func (r *StatisticsRepository) queriesFor(ctx context.Context) *db.Queries {
+	if tx, ok := platformdb.TxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
+// AchievementRepository returns an AchievementRepository implementation
+func (r *StatisticsRepository) AchievementRepository() domain.AchievementRepository {
+	return &achievementRepository{
+		StatisticsRepository: r,
+	}
+}
+
+// LeaderboardRepository returns a LeaderboardRepository implementation
+func (r *StatisticsRepository) LeaderboardRepository() domain.LeaderboardRepository {
+	return &leaderboardRepository{
+		StatisticsRepository: r,
+	}
+}
+
+// UserStatisticsRepository returns a UserStatisticsRepository implementation
+func (r *StatisticsRepository) UserStatisticsRepository() domain.UserStatisticsRepository {
+	return &userStatisticsRepository{
+		StatisticsRepository: r,
+	}
+}
@@ -0,0 +1,223 @@
+package statistics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/statistics"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// userStatisticsRepository implements UserStatisticsRepository using sqlc
+type userStatisticsRepository struct {
+	*StatisticsRepository
+}
+
+// RecordActivity updates user, word, topic and period aggregates in one transaction,
+// the unit-of-work transaction of ctx if there is one
+func (r *userStatisticsRepository) RecordActivity(ctx context.Context, activity domain.Activity) error {
+	err := platformdb.RunInTx(ctx, r.pool, func(ctx context.Context) error {
+		qtx := r.queriesFor(ctx)
+
+		if answer := activity.Answer; answer != nil {
+			answeredAt := pgtype.Timestamp{Time: answer.AnsweredAt, Valid: true}
+
+			// Streak days follow the calendar of the user
+			loc, err := r.userLocation(ctx, qtx, activity.UserID)
+			if err != nil {
+				return err
+			}
+
+			if err := qtx.RecordUserStatisticsAnswer(ctx, db.RecordUserStatisticsAnswerParams{
+				UserID:     activity.UserID,
+				IsCorrect:  answer.IsCorrect,
+				AnsweredAt: answeredAt,
+				PlayedOn:   pgtype.Date{Time: domain.StreakDay(answer.AnsweredAt, loc), Valid: true},
+				Xp:         pgtype.Int4{Int32: int32(answer.XP), Valid: true},
+			}); err != nil {
+				return err
+			}
+
+			if err := qtx.RecordUserWordStatisticsAnswer(ctx, db.RecordUserWordStatisticsAnswerParams{
+				UserID:     activity.UserID,
+				WordID:     answer.WordID,
+				IsCorrect:  answer.IsCorrect,
+				AnsweredAt: answeredAt,
+			}); err != nil {
+				return err
+			}
+
+			if err := qtx.RecordUserTopicStatisticsAnswer(ctx, db.RecordUserTopicStatisticsAnswerParams{
+				UserID:     activity.UserID,
+				IsCorrect:  answer.IsCorrect,
+				AnsweredAt: answeredAt,
+				WordID:     answer.WordID,
+			}); err != nil {
+				return err
+			}
+
+			// Sessions without a level are aggregated under level 0
+			var levelID int64
+			if answer.LevelID != nil {
+				levelID = *answer.LevelID
+			}
+			for _, period := range domain.LeaderboardPeriods {
+				if err := qtx.RecordUserPeriodStatisticsAnswer(ctx, db.RecordUserPeriodStatisticsAnswerParams{
+					UserID:           activity.UserID,
+					PeriodType:       period,
+					PeriodStart:      pgtype.Date{Time: domain.PeriodStart(period, answer.AnsweredAt), Valid: true},
+					SourceLanguageID: answer.SourceLanguageID,
+					TargetLanguageID: answer.TargetLanguageID,
+					LevelID:          levelID,
+					IsCorrect:        answer.IsCorrect,
+					AnsweredAt:       answeredAt,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		if session := activity.CompletedSession; session != nil {
+			if err := qtx.RecordUserStatisticsSession(ctx, db.RecordUserStatisticsSessionParams{
+				UserID:          activity.UserID,
+				DurationSeconds: pgtype.Int4{Int32: int32(session.DurationSeconds), Valid: true},
+				EndedAt:         pgtype.Timestamp{Time: session.EndedAt, Valid: true},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	return sharederrors.MapStatisticsRepositoryError(err, "RecordActivity")
+}
+
+// FindUserStatisticsByUserID returns lifetime statistics of a user (zero values if the user has not played yet)
+func (r *userStatisticsRepository) FindUserStatisticsByUserID(ctx context.Context, userID int64) (*domain.UserStatistics, error) {
+	row, err := r.queries.FindUserStatisticsByUserID(ctx, userID)
+	if err != nil {
+		if sharederrors.IsNotFound(err) {
+			return &domain.UserStatistics{UserID: userID}, nil
+		}
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindUserStatisticsByUserID")
+	}
+
+	var lastPlayedAt *time.Time
+	if row.LastPlayedAt.Valid {
+		lastPlayedAt = &row.LastPlayedAt.Time
+	}
+
+	loc, err := r.userLocation(ctx, r.queries, userID)
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindUserStatisticsByUserID")
+	}
+
+	// The stored streak is only still running if the user played yesterday or today in their time zone
+	var currentStreak int
+	if row.LastPlayedOn.Valid && !row.LastPlayedOn.Time.Before(domain.StreakActiveSince(time.Now(), loc)) {
+		currentStreak = int(row.CurrentStreak.Int32)
+	}
+
+	return &domain.UserStatistics{
+		UserID:           row.UserID,
+		TotalSessions:    int(row.TotalSessions.Int32),
+		TotalQuestions:   int(row.TotalQuestions.Int32),
+		TotalCorrect:     int(row.TotalCorrect.Int32),
+		TotalTimeSeconds: int(row.TotalTimeSeconds.Int32),
+		LastPlayedAt:     lastPlayedAt,
+		CurrentStreak:    currentStreak,
+		LongestStreak:    int(row.LongestStreak.Int32),
+		TotalXP:          int(row.TotalXp.Int32),
+	}, nil
+}
+
+// userLocation returns the location streak days of a user are counted in, UTC without a profile time zone
+func (r *userStatisticsRepository) userLocation(ctx context.Context, queries *db.Queries, userID int64) (*time.Location, error) {
+	timezone, err := queries.FindUserTimezone(ctx, userID)
+	if err != nil {
+		if sharederrors.IsNotFound(err) {
+			return time.UTC, nil
+		}
+		return nil, err
+	}
+	if !timezone.Valid {
+		return time.UTC, nil
+	}
+	return domain.UserLocation(&timezone.String), nil
+}
+
+// FindUserWordStatisticsByUserID returns per-word statistics of a user with pagination
+func (r *userStatisticsRepository) FindUserWordStatisticsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*domain.UserWordStatistics, error) {
+	rows, err := r.queries.FindUserWordStatisticsByUserID(ctx, db.FindUserWordStatisticsByUserIDParams{
+		UserID: userID,
+		Offset: int32(offset),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindUserWordStatisticsByUserID")
+	}
+
+	stats := make([]*domain.UserWordStatistics, 0, len(rows))
+	for _, row := range rows {
+		var lastAnsweredAt *time.Time
+		if row.LastAnsweredAt.Valid {
+			val := row.LastAnsweredAt.Time
+			lastAnsweredAt = &val
+		}
+
+		stats = append(stats, &domain.UserWordStatistics{
+			UserID:         row.UserID,
+			WordID:         row.WordID,
+			Lemma:          row.Lemma,
+			LanguageID:     row.LanguageID,
+			CorrectCount:   int(row.CorrectCount.Int32),
+			WrongCount:     int(row.WrongCount.Int32),
+			Streak:         int(row.Streak.Int32),
+			LastAnsweredAt: lastAnsweredAt,
+		})
+	}
+
+	return stats, nil
+}
+
+// CountUserWordStatisticsByUserID returns the number of words a user has answered
+func (r *userStatisticsRepository) CountUserWordStatisticsByUserID(ctx context.Context, userID int64) (int64, error) {
+	count, err := r.queries.CountUserWordStatisticsByUserID(ctx, userID)
+	if err != nil {
+		return 0, sharederrors.MapStatisticsRepositoryError(err, "CountUserWordStatisticsByUserID")
+	}
+	return count, nil
+}
+
+// FindUserTopicStatisticsByUserID returns per-topic statistics of a user
+func (r *userStatisticsRepository) FindUserTopicStatisticsByUserID(ctx context.Context, userID int64) ([]*domain.UserTopicStatistics, error) {
+	rows, err := r.queries.FindUserTopicStatisticsByUserID(ctx, userID)
+	if err != nil {
+		return nil, sharederrors.MapStatisticsRepositoryError(err, "FindUserTopicStatisticsByUserID")
+	}
+
+	stats := make([]*domain.UserTopicStatistics, 0, len(rows))
+	for _, row := range rows {
+		var lastPlayedAt *time.Time
+		if row.LastPlayedAt.Valid {
+			val := row.LastPlayedAt.Time
+			lastPlayedAt = &val
+		}
+
+		stats = append(stats, &domain.UserTopicStatistics{
+			UserID:         row.UserID,
+			TopicID:        row.TopicID,
+			TopicCode:      row.TopicCode,
+			TopicName:      row.TopicName,
+			TotalQuestions: int(row.TotalQuestions.Int32),
+			TotalCorrect:   int(row.TotalCorrect.Int32),
+			LastPlayedAt:   lastPlayedAt,
+		})
+	}
+
+	return stats, nil
+}
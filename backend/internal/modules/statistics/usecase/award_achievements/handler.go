@@ -0,0 +1,98 @@
+package award_achievements
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler awards achievements to users
+type Handler struct {
+	achievementRepo domain.AchievementRepository
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	achievementRepo domain.AchievementRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		achievementRepo: achievementRepo,
+		logger:          logger,
+	}
+}
+
+// Execute awards every achievement of the catalog the user has reached and not earned yet.
+// It is meant to run after statistics are recorded, e.g. on an answer or a session completion,
+// and is idempotent: an achievement is only earned once.
+func (h *Handler) Execute(ctx context.Context, input AwardAchievementsInput) (*AwardAchievementsOutput, error) {
+	output := &AwardAchievementsOutput{
+		Earned: []EarnedAchievement{},
+	}
+
+	earned, err := h.achievementRepo.FindUserAchievementsByUserID(ctx, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find user achievements",
+			logger.Error(err),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if len(earned) >= len(domain.Achievements) {
+		return output, nil
+	}
+
+	earnedCodes := make(map[string]bool, len(earned))
+	for _, achievement := range earned {
+		earnedCodes[achievement.AchievementCode] = true
+	}
+
+	progress, err := h.achievementRepo.FindAchievementProgress(ctx, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find achievement progress",
+			logger.Error(err),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	now := time.Now()
+	for _, achievement := range domain.Achievements {
+		if earnedCodes[achievement.Code] || !progress.Reached(achievement) {
+			continue
+		}
+
+		// A concurrent award of the same achievement reports false and is not returned twice
+		created, err := h.achievementRepo.CreateUserAchievement(ctx, &domain.UserAchievement{
+			UserID:          input.UserID,
+			AchievementCode: achievement.Code,
+			EarnedAt:        now,
+		})
+		if err != nil {
+			h.logger.Error("failed to award achievement",
+				logger.Error(err),
+				logger.Int64("user_id", input.UserID),
+				logger.String("achievement_code", achievement.Code),
+			)
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+		if !created {
+			continue
+		}
+
+		h.logger.Info("achievement earned",
+			logger.Int64("user_id", input.UserID),
+			logger.String("achievement_code", achievement.Code),
+		)
+		output.Earned = append(output.Earned, EarnedAchievement{
+			Achievement: achievement,
+			EarnedAt:    now,
+		})
+	}
+
+	return output, nil
+}
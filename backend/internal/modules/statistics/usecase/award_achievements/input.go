@@ -0,0 +1,6 @@
+package award_achievements
+
+// AwardAchievementsInput represents the input for awarding achievements use case.
+type AwardAchievementsInput struct {
+	UserID int64
+}
@@ -0,0 +1,18 @@
+package award_achievements
+
+import (
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+)
+
+// AwardAchievementsOutput represents the achievements earned by an awarding use case.
+type AwardAchievementsOutput struct {
+	Earned []EarnedAchievement // Achievements earned by this call, empty most of the time
+}
+
+// EarnedAchievement represents an achievement with the time it was earned.
+type EarnedAchievement struct {
+	Achievement domain.Achievement
+	EarnedAt    time.Time
+}
@@ -0,0 +1,80 @@
+package get_leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler builds the cross-user leaderboards
+type Handler struct {
+	leaderboardRepo domain.LeaderboardRepository
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	leaderboardRepo domain.LeaderboardRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		leaderboardRepo: leaderboardRepo,
+		logger:          logger,
+	}
+}
+
+// Execute ranks the users who played in the current period by the requested metric.
+// Accuracy only ranks users with enough answers in the period, so that a single lucky answer does not top the board.
+func (h *Handler) Execute(ctx context.Context, input GetLeaderboardInput) (*GetLeaderboardOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
+	}
+
+	now := time.Now()
+	query := domain.LeaderboardQuery{
+		Metric:           input.EffectiveMetric(),
+		Period:           input.EffectivePeriod(),
+		SourceLanguageID: input.SourceLanguageID,
+		TargetLanguageID: input.TargetLanguageID,
+		LevelID:          input.LevelID,
+		MinQuestions:     1,
+		Now:              now,
+	}
+	query.PeriodStart = domain.PeriodStart(query.Period, now)
+	if query.Metric == domain.LeaderboardMetricAccuracy {
+		query.MinQuestions = constants.LeaderboardAccuracyMinQuestions
+	}
+
+	entries, err := h.leaderboardRepo.FindLeaderboard(ctx, query, input.Limit, input.Offset)
+	if err != nil {
+		h.logger.Error("failed to find leaderboard",
+			logger.Error(err),
+			logger.String("metric", query.Metric),
+			logger.String("period", query.Period),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	total, err := h.leaderboardRepo.CountLeaderboard(ctx, query)
+	if err != nil {
+		h.logger.Error("failed to count leaderboard",
+			logger.Error(err),
+			logger.String("metric", query.Metric),
+			logger.String("period", query.Period),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	return &GetLeaderboardOutput{
+		Metric:       query.Metric,
+		Period:       query.Period,
+		PeriodStart:  query.PeriodStart,
+		MinQuestions: query.MinQuestions,
+		Entries:      entries,
+		Total:        total,
+	}, nil
+}
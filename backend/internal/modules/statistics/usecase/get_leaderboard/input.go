@@ -0,0 +1,51 @@
+package get_leaderboard
+
+import (
+	"errors"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+)
+
+// GetLeaderboardInput represents the input for getting a leaderboard use case.
+type GetLeaderboardInput struct {
+	Metric           string // 'correct_answers', 'accuracy' or 'streak'; empty means 'correct_answers'
+	Period           string // 'weekly', 'monthly' or 'all_time'; empty means 'weekly'
+	SourceLanguageID *int16 // Optional
+	TargetLanguageID *int16 // Optional
+	LevelID          *int64 // Optional, 0 means sessions without a level
+	Limit            int
+	Offset           int
+}
+
+// Validate validates the get leaderboard input
+func (r *GetLeaderboardInput) Validate() error {
+	if r.Metric != "" && !domain.IsValidLeaderboardMetric(r.Metric) {
+		return errors.New("Tiêu chí xếp hạng phải là 'correct_answers', 'accuracy' hoặc 'streak'")
+	}
+	if r.Period != "" && !domain.IsValidLeaderboardPeriod(r.Period) {
+		return errors.New("Khoảng thời gian phải là 'weekly', 'monthly' hoặc 'all_time'")
+	}
+	if r.SourceLanguageID != nil && r.TargetLanguageID != nil && *r.SourceLanguageID == *r.TargetLanguageID {
+		return errors.New("Ngôn ngữ nguồn và ngôn ngữ đích phải khác nhau")
+	}
+	if r.LevelID != nil && *r.LevelID < 0 {
+		return errors.New("ID cấp độ không hợp lệ")
+	}
+	return nil
+}
+
+// EffectiveMetric returns the metric to rank by, defaulting to correct answers
+func (r *GetLeaderboardInput) EffectiveMetric() string {
+	if r.Metric == "" {
+		return domain.LeaderboardMetricCorrectAnswers
+	}
+	return r.Metric
+}
+
+// EffectivePeriod returns the period to rank over, defaulting to the current week
+func (r *GetLeaderboardInput) EffectivePeriod() string {
+	if r.Period == "" {
+		return domain.LeaderboardPeriodWeekly
+	}
+	return r.Period
+}
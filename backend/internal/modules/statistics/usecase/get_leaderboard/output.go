@@ -0,0 +1,17 @@
+package get_leaderboard
+
+import (
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+)
+
+// GetLeaderboardOutput represents a page of a leaderboard.
+type GetLeaderboardOutput struct {
+	Metric       string
+	Period       string
+	PeriodStart  time.Time
+	MinQuestions int // Answers in the period needed to be ranked
+	Entries      []*domain.LeaderboardEntry
+	Total        int64
+}
@@ -0,0 +1,266 @@
+package get_session_statistics
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	gamedomain "github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler builds statistics for a vocabgame session
+type Handler struct {
+	sessionRepo  gamedomain.GameSessionRepository
+	questionRepo gamedomain.GameQuestionRepository
+	answerRepo   gamedomain.GameAnswerRepository
+	wordRepo     dictdomain.WordRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo gamedomain.GameSessionRepository,
+	questionRepo gamedomain.GameQuestionRepository,
+	answerRepo gamedomain.GameAnswerRepository,
+	wordRepo dictdomain.WordRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:  sessionRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		wordRepo:     wordRepo,
+		logger:       logger,
+	}
+}
+
+// Execute computes accuracy, response times, per-question correctness and missed words of a session
+func (h *Handler) Execute(ctx context.Context, input GetSessionStatisticsInput) (*GetSessionStatisticsOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(gamedomain.ErrSessionNotFound)
+	}
+
+	// Verify user owns session
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(gamedomain.ErrSessionNotOwned)
+	}
+
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answerMap := make(map[int64]*gamedomain.GameAnswer, len(answers))
+	for _, answer := range answers {
+		answerMap[answer.QuestionID] = answer
+	}
+
+	wordMap, err := h.loadWords(ctx, questions, answerMap)
+	if err != nil {
+		h.logger.Error("failed to load session words",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &GetSessionStatisticsOutput{
+		SessionID:      session.ID,
+		TotalQuestions: int(session.TotalQuestions),
+		Questions:      make([]QuestionStatistics, 0, len(questions)),
+		MissedWords:    make([]MissedWord, 0),
+	}
+	if output.TotalQuestions == 0 {
+		output.TotalQuestions = len(questions)
+	}
+
+	responseTimes := make([]int, 0, len(answers))
+	missedWordIDs := make(map[int64]bool)
+	for _, q := range questions {
+		stat := QuestionStatistics{
+			QuestionID:      q.ID,
+			QuestionOrder:   q.QuestionOrder,
+			SourceWordID:    q.SourceWordID,
+			SourceWordText:  lemmaOf(wordMap, q.SourceWordID),
+			CorrectWordID:   q.CorrectTargetWordID,
+			CorrectWordText: lemmaOf(wordMap, q.CorrectTargetWordID),
+		}
+
+		answer := answerMap[q.ID]
+		if answer != nil {
+			stat.Answered = true
+			stat.IsCorrect = answer.IsCorrect
+			stat.SelectedOptionID = answer.SelectedOptionID
+			stat.ResponseTimeMs = answer.ResponseTimeMs
+			if selected := findOption(q, answer.SelectedOptionID); selected != nil {
+				text := lemmaOf(wordMap, selected.TargetWordID)
+				stat.SelectedWordText = &text
+			} else if answer.AnswerText != nil {
+				// Typed answers have no option, the typed text is what the learner answered
+				stat.SelectedWordText = answer.AnswerText
+			}
+
+			output.AnsweredQuestions++
+			if answer.IsCorrect {
+				output.CorrectAnswers++
+			} else {
+				output.WrongAnswers++
+				if !missedWordIDs[q.SourceWordID] {
+					missedWordIDs[q.SourceWordID] = true
+					output.MissedWords = append(output.MissedWords, MissedWord{
+						WordID:           q.SourceWordID,
+						Lemma:            stat.SourceWordText,
+						CorrectWordID:    q.CorrectTargetWordID,
+						CorrectWordText:  stat.CorrectWordText,
+						SelectedWordText: stat.SelectedWordText,
+					})
+				}
+			}
+			if answer.ResponseTimeMs != nil {
+				responseTimes = append(responseTimes, *answer.ResponseTimeMs)
+			}
+		}
+
+		output.Questions = append(output.Questions, stat)
+	}
+
+	if output.AnsweredQuestions > 0 {
+		output.AccuracyPercentage = roundTwoDecimals(float64(output.CorrectAnswers) * 100 / float64(output.AnsweredQuestions))
+	}
+	output.AverageResponseTimeMs = roundTwoDecimals(average(responseTimes))
+	output.MedianResponseTimeMs = roundTwoDecimals(median(responseTimes))
+	output.DurationSeconds = sessionDurationSeconds(session, answers)
+
+	return output, nil
+}
+
+// loadWords fetches all words referenced by the questions and selected options in one batch
+func (h *Handler) loadWords(ctx context.Context, questions []*gamedomain.GameQuestion, answerMap map[int64]*gamedomain.GameAnswer) (map[int64]*dictdomain.Word, error) {
+	wordIDs := make(map[int64]bool)
+	for _, q := range questions {
+		wordIDs[q.SourceWordID] = true
+		wordIDs[q.CorrectTargetWordID] = true
+		if answer := answerMap[q.ID]; answer != nil {
+			if selected := findOption(q, answer.SelectedOptionID); selected != nil {
+				wordIDs[selected.TargetWordID] = true
+			}
+		}
+	}
+
+	wordMap := make(map[int64]*dictdomain.Word, len(wordIDs))
+	if len(wordIDs) == 0 {
+		return wordMap, nil
+	}
+
+	wordIDList := make([]int64, 0, len(wordIDs))
+	for id := range wordIDs {
+		wordIDList = append(wordIDList, id)
+	}
+
+	words, err := h.wordRepo.FindWordsByIDs(ctx, wordIDList)
+	if err != nil {
+		return nil, err
+	}
+	for _, word := range words {
+		wordMap[word.ID] = word
+	}
+
+	return wordMap, nil
+}
+
+// findOption returns the option of the question with the given ID
+func findOption(question *gamedomain.GameQuestion, optionID *int64) *gamedomain.GameQuestionOption {
+	if optionID == nil {
+		return nil
+	}
+	for _, opt := range question.Options {
+		if opt.ID == *optionID {
+			return opt
+		}
+	}
+	return nil
+}
+
+// lemmaOf returns the lemma of a word or an empty string if the word is unknown
+func lemmaOf(wordMap map[int64]*dictdomain.Word, wordID int64) string {
+	if word := wordMap[wordID]; word != nil {
+		return word.Lemma
+	}
+	return ""
+}
+
+// sessionDurationSeconds returns the session duration, using the last answer for sessions still in progress
+func sessionDurationSeconds(session *gamedomain.GameSession, answers []*gamedomain.GameAnswer) int {
+	end := session.StartedAt
+	if session.EndedAt != nil {
+		end = *session.EndedAt
+	} else {
+		for _, answer := range answers {
+			if answer.AnsweredAt.After(end) {
+				end = answer.AnsweredAt
+			}
+		}
+	}
+
+	duration := end.Sub(session.StartedAt)
+	if duration < 0 {
+		return 0
+	}
+	return int(duration / time.Second)
+}
+
+func average(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return float64(sum) / float64(len(values))
+}
+
+func median(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]int, len(values))
+	copy(sorted, values)
+	sort.Ints(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return float64(sorted[mid-1]+sorted[mid]) / 2
+	}
+	return float64(sorted[mid])
+}
+
+func roundTwoDecimals(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,7 @@
+package get_session_statistics
+
+// GetSessionStatisticsInput represents the input for getting session statistics use case.
+type GetSessionStatisticsInput struct {
+	SessionID int64
+	UserID    int64
+}
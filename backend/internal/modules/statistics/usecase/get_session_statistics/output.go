@@ -0,0 +1,40 @@
+package get_session_statistics
+
+// GetSessionStatisticsOutput represents the statistics of a vocabgame session.
+type GetSessionStatisticsOutput struct {
+	SessionID             int64
+	TotalQuestions        int
+	AnsweredQuestions     int
+	CorrectAnswers        int
+	WrongAnswers          int
+	AccuracyPercentage    float64
+	DurationSeconds       int
+	AverageResponseTimeMs float64
+	MedianResponseTimeMs  float64
+	Questions             []QuestionStatistics
+	MissedWords           []MissedWord
+}
+
+// QuestionStatistics represents the outcome of a single question in the session.
+type QuestionStatistics struct {
+	QuestionID       int64
+	QuestionOrder    int16
+	SourceWordID     int64
+	SourceWordText   string
+	CorrectWordID    int64
+	CorrectWordText  string
+	SelectedOptionID *int64
+	SelectedWordText *string
+	Answered         bool
+	IsCorrect        bool
+	ResponseTimeMs   *int
+}
+
+// MissedWord represents a word that was answered incorrectly in the session.
+type MissedWord struct {
+	WordID           int64
+	Lemma            string
+	CorrectWordID    int64
+	CorrectWordText  string
+	SelectedWordText *string
+}
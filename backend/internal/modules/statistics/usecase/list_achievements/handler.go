@@ -0,0 +1,83 @@
+package list_achievements
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler lists the achievements of a user
+type Handler struct {
+	achievementRepo domain.AchievementRepository
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	achievementRepo domain.AchievementRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		achievementRepo: achievementRepo,
+		logger:          logger,
+	}
+}
+
+// Execute returns the achievements of the catalog, in catalog order, with whether and when
+// the user earned them and how far the user is from the available ones.
+func (h *Handler) Execute(ctx context.Context, input ListAchievementsInput) (*ListAchievementsOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
+	}
+
+	earned, err := h.achievementRepo.FindUserAchievementsByUserID(ctx, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find user achievements",
+			logger.Error(err),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	earnedAt := make(map[string]time.Time, len(earned))
+	for _, achievement := range earned {
+		earnedAt[achievement.AchievementCode] = achievement.EarnedAt
+	}
+
+	var progress *domain.AchievementProgress
+	if input.Status != StatusEarned {
+		progress, err = h.achievementRepo.FindAchievementProgress(ctx, input.UserID)
+		if err != nil {
+			h.logger.Error("failed to find achievement progress",
+				logger.Error(err),
+				logger.Int64("user_id", input.UserID),
+			)
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+	}
+
+	output := &ListAchievementsOutput{
+		Achievements: make([]AchievementStatus, 0, len(domain.Achievements)),
+	}
+	for _, achievement := range domain.Achievements {
+		status := AchievementStatus{Achievement: achievement}
+		if at, ok := earnedAt[achievement.Code]; ok {
+			if input.Status == StatusAvailable {
+				continue
+			}
+			status.EarnedAt = &at
+			status.Progress = achievement.Target
+		} else {
+			if input.Status == StatusEarned {
+				continue
+			}
+			status.Progress = progress.Of(achievement)
+		}
+		output.Achievements = append(output.Achievements, status)
+	}
+
+	return output, nil
+}
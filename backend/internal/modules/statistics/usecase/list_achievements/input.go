@@ -0,0 +1,27 @@
+package list_achievements
+
+import (
+	"errors"
+)
+
+// Achievement statuses the list can be filtered by
+const (
+	// StatusEarned selects the achievements the user has earned
+	StatusEarned = "earned"
+	// StatusAvailable selects the achievements the user can still earn
+	StatusAvailable = "available"
+)
+
+// ListAchievementsInput represents the input for listing achievements use case.
+type ListAchievementsInput struct {
+	UserID int64
+	Status string // 'earned' or 'available'; empty means both
+}
+
+// Validate validates the list achievements input
+func (r *ListAchievementsInput) Validate() error {
+	if r.Status != "" && r.Status != StatusEarned && r.Status != StatusAvailable {
+		return errors.New("Trạng thái phải là 'earned' hoặc 'available'")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package list_achievements
+
+import (
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/statistics/domain"
+)
+
+// ListAchievementsOutput represents the output for listing achievements use case.
+type ListAchievementsOutput struct {
+	Achievements []AchievementStatus
+}
+
+// AchievementStatus represents an achievement of the catalog with the progress of the user toward it.
+type AchievementStatus struct {
+	Achievement domain.Achievement
+	Progress    int        // Capped at the target of the achievement
+	EarnedAt    *time.Time // nil while the achievement is available
+}
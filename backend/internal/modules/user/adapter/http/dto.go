@@ -32,28 +32,34 @@ type LoginResponse struct {
 
 // UpdateProfileRequest represents the request body for updating user profile
 type UpdateProfileRequest struct {
-	DisplayName *string `json:"display_name,omitempty" binding:"omitempty,max=100"`
-	AvatarURL   *string `json:"avatar_url,omitempty" binding:"omitempty,url,max=500"`
-	BirthDay    *string `json:"birth_day,omitempty" binding:"omitempty,datetime=2006-01-02"`
-	Bio         *string `json:"bio,omitempty"`
+	DisplayName   *string `json:"display_name,omitempty" binding:"omitempty,max=100"`
+	AvatarURL     *string `json:"avatar_url,omitempty" binding:"omitempty,url,max=500"`
+	BirthDay      *string `json:"birth_day,omitempty" binding:"omitempty,datetime=2006-01-02"`
+	Bio           *string `json:"bio,omitempty"`
+	Timezone      *string `json:"timezone,omitempty" binding:"omitempty,max=64"`
+	ShareProgress *bool   `json:"share_progress,omitempty"`
 }
 
 // UserProfileResponse represents the user profile response body
 type UserProfileResponse struct {
-	UserID      int64   `json:"user_id"`
-	DisplayName *string `json:"display_name,omitempty"`
-	AvatarURL   *string `json:"avatar_url,omitempty"`
-	BirthDay    *string `json:"birth_day,omitempty"`
-	Bio         *string `json:"bio,omitempty"`
+	UserID        int64   `json:"user_id"`
+	DisplayName   *string `json:"display_name,omitempty"`
+	AvatarURL     *string `json:"avatar_url,omitempty"`
+	BirthDay      *string `json:"birth_day,omitempty"`
+	Bio           *string `json:"bio,omitempty"`
+	Timezone      *string `json:"timezone,omitempty"`
+	ShareProgress bool    `json:"share_progress"`
 }
 
 // UpdateProfileResponse represents the response body for updating user profile
 type UpdateProfileResponse struct {
-	UserID      int64   `json:"user_id"`
-	DisplayName *string `json:"display_name,omitempty"`
-	AvatarURL   *string `json:"avatar_url,omitempty"`
-	BirthDay    *string `json:"birth_day,omitempty"`
-	Bio         *string `json:"bio,omitempty"`
+	UserID        int64   `json:"user_id"`
+	DisplayName   *string `json:"display_name,omitempty"`
+	AvatarURL     *string `json:"avatar_url,omitempty"`
+	BirthDay      *string `json:"birth_day,omitempty"`
+	Bio           *string `json:"bio,omitempty"`
+	Timezone      *string `json:"timezone,omitempty"`
+	ShareProgress bool    `json:"share_progress"`
 }
 
 // CheckEmailAvailabilityResponse represents the response for email availability check
@@ -20,8 +20,8 @@ type Handler struct {
 	loginUC         *userlogin.Handler
 	getProfileUC    *usergetprofile.Handler
 	updateProfileUC *userupdateprofile.Handler
-	userRepo    domain.UserRepository
-	profileRepo domain.UserProfileRepository
+	userRepo        domain.UserRepository
+	profileRepo     domain.UserProfileRepository
 }
 
 // NewHandler creates a new user handler
@@ -73,7 +73,7 @@ func (h *Handler) Register(c *gin.Context) {
 	// Note: Profile creation failure doesn't fail registration
 	// This is a business decision - registration succeeds even if profile creation fails
 	if req.DisplayName != nil && *req.DisplayName != "" {
-		_, _ = h.profileRepo.Create(ctx, result.UserID, req.DisplayName, nil, nil, nil)
+		_, _ = h.profileRepo.Create(ctx, result.UserID, req.DisplayName, nil, nil, nil, nil)
 	}
 
 	resp := RegisterResponse{
@@ -150,11 +150,13 @@ func (h *Handler) GetProfile(c *gin.Context) {
 	}
 
 	resp := UserProfileResponse{
-		UserID:      profile.UserID,
-		DisplayName: profile.DisplayName,
-		AvatarURL:   profile.AvatarURL,
-		BirthDay:    profile.BirthDay,
-		Bio:         profile.Bio,
+		UserID:        profile.UserID,
+		DisplayName:   profile.DisplayName,
+		AvatarURL:     profile.AvatarURL,
+		BirthDay:      profile.BirthDay,
+		Bio:           profile.Bio,
+		Timezone:      profile.Timezone,
+		ShareProgress: profile.ShareProgress,
 	}
 
 	response.Success(c, http.StatusOK, resp)
@@ -194,10 +196,12 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	}
 
 	result, err := h.updateProfileUC.Execute(ctx, userIDInt64, userupdateprofile.UpdateProfileInput{
-		DisplayName: req.DisplayName,
-		AvatarURL:   req.AvatarURL,
-		BirthDay:    req.BirthDay,
-		Bio:         req.Bio,
+		DisplayName:   req.DisplayName,
+		AvatarURL:     req.AvatarURL,
+		BirthDay:      req.BirthDay,
+		Bio:           req.Bio,
+		Timezone:      req.Timezone,
+		ShareProgress: req.ShareProgress,
 	})
 
 	if err != nil {
@@ -206,11 +210,13 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	}
 
 	resp := UpdateProfileResponse{
-		UserID:      result.UserID,
-		DisplayName: result.DisplayName,
-		AvatarURL:   result.AvatarURL,
-		BirthDay:    result.BirthDay,
-		Bio:         result.Bio,
+		UserID:        result.UserID,
+		DisplayName:   result.DisplayName,
+		AvatarURL:     result.AvatarURL,
+		BirthDay:      result.BirthDay,
+		Bio:           result.Bio,
+		Timezone:      result.Timezone,
+		ShareProgress: result.ShareProgress,
 	}
 
 	response.Success(c, http.StatusOK, resp)
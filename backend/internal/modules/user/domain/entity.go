@@ -15,12 +15,13 @@ type User struct {
 
 // UserProfile represents extended user profile information
 type UserProfile struct {
-	UserID      int64      `json:"user_id"`
-	DisplayName *string    `json:"display_name,omitempty"`
-	AvatarURL   *string    `json:"avatar_url,omitempty"`
-	BirthDay    *time.Time `json:"birth_day,omitempty"`
-	Bio         *string    `json:"bio,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	UserID        int64      `json:"user_id"`
+	DisplayName   *string    `json:"display_name,omitempty"`
+	AvatarURL     *string    `json:"avatar_url,omitempty"`
+	BirthDay      *time.Time `json:"birth_day,omitempty"`
+	Bio           *string    `json:"bio,omitempty"`
+	Timezone      *string    `json:"timezone,omitempty"` // IANA time zone, nil means UTC
+	ShareProgress bool       `json:"share_progress"`     // whether other users may watch the live progress
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
-
@@ -27,9 +27,9 @@ type UserRepository interface {
 // UserProfileRepository defines operations for user profile data access
 type UserProfileRepository interface {
 	// Create creates a new user profile
-	Create(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*UserProfile, error)
+	Create(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, timezone *string) (*UserProfile, error)
 	// FindUserProfileByUserID returns a user profile by user ID
 	FindUserProfileByUserID(ctx context.Context, userID int64) (*UserProfile, error)
 	// Update updates a user profile
-	Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*UserProfile, error)
+	Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, timezone *string, shareProgress *bool) (*UserProfile, error)
 }
@@ -17,7 +17,7 @@ type userProfileRepository struct {
 }
 
 // Create creates a new user profile
-func (r *userProfileRepository) Create(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*domain.UserProfile, error) {
+func (r *userProfileRepository) Create(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, timezone *string) (*domain.UserProfile, error) {
 	var displayNamePg pgtype.Text
 	if displayName != nil && *displayName != "" {
 		displayNamePg = pgtype.Text{String: *displayName, Valid: true}
@@ -41,12 +41,18 @@ func (r *userProfileRepository) Create(ctx context.Context, userID int64, displa
 		bioPg = pgtype.Text{String: *bio, Valid: true}
 	}
 
+	var timezonePg pgtype.Text
+	if timezone != nil && *timezone != "" {
+		timezonePg = pgtype.Text{String: *timezone, Valid: true}
+	}
+
 	row, err := r.queries.CreateUserProfile(ctx, db.CreateUserProfileParams{
 		UserID:      userID,
 		DisplayName: displayNamePg,
 		AvatarUrl:   avatarURLPg,
 		BirthDay:    birthDayPg,
 		Bio:         bioPg,
+		Timezone:    timezonePg,
 	})
 	if err != nil {
 		return nil, sharederrors.MapUserRepositoryError(err, "Create")
@@ -66,7 +72,7 @@ func (r *userProfileRepository) FindUserProfileByUserID(ctx context.Context, use
 }
 
 // Update updates a user profile
-func (r *userProfileRepository) Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*domain.UserProfile, error) {
+func (r *userProfileRepository) Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, timezone *string, shareProgress *bool) (*domain.UserProfile, error) {
 	var displayNamePg pgtype.Text
 	if displayName != nil && *displayName != "" {
 		displayNamePg = pgtype.Text{String: *displayName, Valid: true}
@@ -90,12 +96,24 @@ func (r *userProfileRepository) Update(ctx context.Context, userID int64, displa
 		bioPg = pgtype.Text{String: *bio, Valid: true}
 	}
 
+	var timezonePg pgtype.Text
+	if timezone != nil && *timezone != "" {
+		timezonePg = pgtype.Text{String: *timezone, Valid: true}
+	}
+
+	var shareProgressPg pgtype.Bool
+	if shareProgress != nil {
+		shareProgressPg = pgtype.Bool{Bool: *shareProgress, Valid: true}
+	}
+
 	row, err := r.queries.UpdateUserProfile(ctx, db.UpdateUserProfileParams{
-		UserID:      userID,
-		DisplayName: displayNamePg,
-		AvatarUrl:   avatarURLPg,
-		BirthDay:    birthDayPg,
-		Bio:         bioPg,
+		UserID:        userID,
+		DisplayName:   displayNamePg,
+		AvatarUrl:     avatarURLPg,
+		BirthDay:      birthDayPg,
+		Bio:           bioPg,
+		Timezone:      timezonePg,
+		ShareProgress: shareProgressPg,
 	})
 	if err != nil {
 		return nil, sharederrors.MapUserRepositoryError(err, "Update")
@@ -110,6 +128,7 @@ func mapDBProfileToModel(row *db.UserProfile) *domain.UserProfile {
 	var avatarURL *string
 	var birthDay *time.Time
 	var bio *string
+	var timezone *string
 
 	if row.DisplayName.Valid {
 		displayName = &row.DisplayName.String
@@ -123,14 +142,19 @@ func mapDBProfileToModel(row *db.UserProfile) *domain.UserProfile {
 	if row.Bio.Valid {
 		bio = &row.Bio.String
 	}
+	if row.Timezone.Valid {
+		timezone = &row.Timezone.String
+	}
 
 	return &domain.UserProfile{
-		UserID:      row.UserID,
-		DisplayName: displayName,
-		AvatarURL:   avatarURL,
-		BirthDay:    birthDay,
-		Bio:         bio,
-		CreatedAt:   row.CreatedAt.Time,
-		UpdatedAt:   row.UpdatedAt.Time,
+		UserID:        row.UserID,
+		DisplayName:   displayName,
+		AvatarURL:     avatarURL,
+		BirthDay:      birthDay,
+		Bio:           bio,
+		Timezone:      timezone,
+		ShareProgress: row.ShareProgress.Valid && row.ShareProgress.Bool,
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
 	}
 }
@@ -1,10 +1,27 @@
 package update_profile
 
+import (
+	"errors"
+	"time"
+)
+
 // UpdateProfileInput represents the input for updating user profile use case.
 type UpdateProfileInput struct {
-	DisplayName *string
-	AvatarURL   *string
-	BirthDay    *string // Format: YYYY-MM-DD
-	Bio         *string
+	DisplayName   *string
+	AvatarURL     *string
+	BirthDay      *string // Format: YYYY-MM-DD
+	Bio           *string
+	Timezone      *string // IANA time zone, e.g. "Asia/Ho_Chi_Minh"
+	ShareProgress *bool   // lets other users watch the live progress
 }
 
+// Validate validates the UpdateProfileInput.
+func (r *UpdateProfileInput) Validate() error {
+	// Streaks are counted in this time zone, so it must be an IANA name the database knows as well
+	if r.Timezone != nil && *r.Timezone != "" {
+		if _, err := time.LoadLocation(*r.Timezone); err != nil || *r.Timezone == "Local" {
+			return errors.New("Múi giờ không hợp lệ, ví dụ hợp lệ: 'Asia/Ho_Chi_Minh'")
+		}
+	}
+	return nil
+}
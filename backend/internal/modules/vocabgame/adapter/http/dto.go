@@ -2,49 +2,116 @@ package http
 
 import (
 	"time"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
 )
 
 // CreateSessionRequest represents the request body for creating a vocabgame session
 type CreateSessionRequest struct {
-	Mode             string  `json:"mode" binding:"required"`
-	SourceLanguageID int16   `json:"source_language_id" binding:"required"`
-	TargetLanguageID int16   `json:"target_language_id" binding:"required"`
-	LevelID          int64   `json:"level_id" binding:"required"`
-	TopicIDs         []int64 `json:"topic_ids,omitempty"`
+	Mode                string   `json:"mode" binding:"required"`
+	SourceLanguageID    int16    `json:"source_language_id" binding:"required"`
+	TargetLanguageID    int16    `json:"target_language_id" binding:"required"`
+	LevelID             int64    `json:"level_id"`
+	TopicIDs            []int64  `json:"topic_ids,omitempty"`
+	QuestionTypes       []string `json:"question_types,omitempty"`
+	QuestionCount       int      `json:"question_count,omitempty"`         // 1-20, defaults to 20
+	OptionCount         int      `json:"option_count,omitempty"`           // 2-6, defaults to 4
+	QuestionTimeLimitMs *int     `json:"question_time_limit_ms,omitempty"` // 3000-300000, omitted means no limit
 }
 
 // CreateSessionResponse represents the response body for creating a vocabgame session
 type CreateSessionResponse struct {
-	ID               int64     `json:"id"`
-	UserID           int64     `json:"user_id"`
-	Mode             string    `json:"mode"`
-	SourceLanguageID int16     `json:"source_language_id"`
-	TargetLanguageID int16     `json:"target_language_id"`
-	TopicID          *int64    `json:"topic_id,omitempty"`
-	LevelID          *int64    `json:"level_id,omitempty"`
-	TotalQuestions   int16     `json:"total_questions"`
-	CorrectQuestions int16     `json:"correct_questions"`
-	StartedAt        time.Time `json:"started_at"`
-	EndedAt          *time.Time `json:"ended_at,omitempty"`
+	ID                  int64      `json:"id"`
+	UserID              int64      `json:"user_id"`
+	Mode                string     `json:"mode"`
+	SourceLanguageID    int16      `json:"source_language_id"`
+	TargetLanguageID    int16      `json:"target_language_id"`
+	TopicID             *int64     `json:"topic_id,omitempty"`
+	LevelID             *int64     `json:"level_id,omitempty"`
+	TotalQuestions      int16      `json:"total_questions"`
+	CorrectQuestions    int16      `json:"correct_questions"`
+	StartedAt           time.Time  `json:"started_at"`
+	EndedAt             *time.Time `json:"ended_at,omitempty"`
+	OptionCount         int16      `json:"option_count"`
+	QuestionTimeLimitMs *int       `json:"question_time_limit_ms,omitempty"`
+	ChallengeDate       *string    `json:"challenge_date,omitempty"` // Daily challenges only, YYYY-MM-DD
+}
+
+// StartDailyChallengeRequest represents the request body for starting the daily challenge
+type StartDailyChallengeRequest struct {
+	SourceLanguageID int16 `json:"source_language_id" binding:"required"`
+	TargetLanguageID int16 `json:"target_language_id" binding:"required"`
+}
+
+// DailyChallengeLeaderboardRequest represents the query parameters for the daily challenge leaderboard
+type DailyChallengeLeaderboardRequest struct {
+	SourceLanguageID int16  `form:"source_language_id" binding:"required"`
+	TargetLanguageID int16  `form:"target_language_id" binding:"required"`
+	Date             string `form:"date" binding:"omitempty,datetime=2006-01-02"` // Defaults to today (UTC)
+}
+
+// WatchProgressRequest represents the query parameters of the live progress stream
+type WatchProgressRequest struct {
+	UserIDs []int64 `form:"user_ids" collection_format:"csv"` // Comma-separated, defaults to the current user
+}
+
+// DailyChallengeEntryResponse represents a user's result on the daily challenge leaderboard
+type DailyChallengeEntryResponse struct {
+	Rank                int       `json:"rank"`
+	SessionID           int64     `json:"session_id"`
+	UserID              int64     `json:"user_id"`
+	DisplayName         string    `json:"display_name"`
+	CorrectQuestions    int16     `json:"correct_questions"`
+	TotalQuestions      int16     `json:"total_questions"`
+	TotalResponseTimeMs int64     `json:"total_response_time_ms"`
+	EndedAt             time.Time `json:"ended_at"`
 }
 
 // SubmitAnswerRequest represents the request body for submitting an answer
 type SubmitAnswerRequest struct {
-	QuestionID       int64 `json:"question_id" binding:"required"`
-	SelectedOptionID int64 `json:"selected_option_id" binding:"required"`
-	ResponseTimeMs   *int  `json:"response_time_ms,omitempty"`
+	QuestionID       int64   `json:"question_id" binding:"required"`
+	SelectedOptionID *int64  `json:"selected_option_id,omitempty"` // Required for multiple-choice questions
+	AnswerText       *string `json:"answer_text,omitempty"`        // Required for typed questions
+	ResponseTimeMs   *int    `json:"response_time_ms,omitempty"`
 }
 
 // SubmitAnswerResponse represents the response body for submitting an answer
 type SubmitAnswerResponse struct {
-	ID               int64     `json:"id"`
-	QuestionID       int64     `json:"question_id"`
-	SessionID        int64     `json:"session_id"`
-	UserID           int64     `json:"user_id"`
-	SelectedOptionID *int64    `json:"selected_option_id,omitempty"`
-	IsCorrect        bool      `json:"is_correct"`
-	ResponseTimeMs   *int      `json:"response_time_ms,omitempty"`
-	AnsweredAt       time.Time `json:"answered_at"`
+	ID               int64                       `json:"id"`
+	QuestionID       int64                       `json:"question_id"`
+	SessionID        int64                       `json:"session_id"`
+	UserID           int64                       `json:"user_id"`
+	SelectedOptionID *int64                      `json:"selected_option_id,omitempty"`
+	IsCorrect        bool                        `json:"is_correct"`
+	ResponseTimeMs   *int                        `json:"response_time_ms,omitempty"`
+	AnswerText       *string                     `json:"answer_text,omitempty"`
+	MatchResult      *string                     `json:"match_result,omitempty"`    // Typed questions only: 'correct', 'almost' or 'wrong'
+	ExpectedAnswer   *string                     `json:"expected_answer,omitempty"` // Typed questions only
+	AnsweredAt       time.Time                   `json:"answered_at"`
+	SessionCompleted bool                        `json:"session_completed"`
+	Summary          *SessionSummaryResponse     `json:"summary,omitempty"`
+	NextReviewAt     *time.Time                  `json:"next_review_at,omitempty"`
+	XPEarned         int                         `json:"xp_earned"`
+	NewAchievements  []EarnedAchievementResponse `json:"new_achievements,omitempty"`
+}
+
+// SessionSummaryResponse represents the summary of a completed vocabgame session
+type SessionSummaryResponse struct {
+	Session             GameSessionResponse         `json:"session"`
+	AnsweredQuestions   int                         `json:"answered_questions"`
+	WrongAnswers        int                         `json:"wrong_answers"`
+	UnansweredQuestions int                         `json:"unanswered_questions"`
+	AccuracyPercentage  float64                     `json:"accuracy_percentage"`
+	DurationSeconds     int                         `json:"duration_seconds"`
+	NewAchievements     []EarnedAchievementResponse `json:"new_achievements,omitempty"` // Manual completion only
+}
+
+// EarnedAchievementResponse represents an achievement just earned by the user
+type EarnedAchievementResponse struct {
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	EarnedAt    time.Time `json:"earned_at"`
 }
 
 // GetSessionRequest represents the path parameter for getting a session
@@ -54,17 +121,20 @@ type GetSessionRequest struct {
 
 // GameSessionResponse represents a vocabgame session for HTTP response
 type GameSessionResponse struct {
-	ID               int64      `json:"id"`
-	UserID           int64      `json:"user_id"`
-	Mode             string     `json:"mode"`
-	SourceLanguageID int16      `json:"source_language_id"`
-	TargetLanguageID int16      `json:"target_language_id"`
-	TopicID          *int64     `json:"topic_id,omitempty"`
-	LevelID          *int64     `json:"level_id,omitempty"`
-	TotalQuestions   int16      `json:"total_questions"`
-	CorrectQuestions int16      `json:"correct_questions"`
-	StartedAt        time.Time  `json:"started_at"`
-	EndedAt          *time.Time `json:"ended_at,omitempty"`
+	ID                  int64      `json:"id"`
+	UserID              int64      `json:"user_id"`
+	Mode                string     `json:"mode"`
+	SourceLanguageID    int16      `json:"source_language_id"`
+	TargetLanguageID    int16      `json:"target_language_id"`
+	TopicID             *int64     `json:"topic_id,omitempty"`
+	LevelID             *int64     `json:"level_id,omitempty"`
+	TotalQuestions      int16      `json:"total_questions"`
+	CorrectQuestions    int16      `json:"correct_questions"`
+	StartedAt           time.Time  `json:"started_at"`
+	EndedAt             *time.Time `json:"ended_at,omitempty"`
+	OptionCount         int16      `json:"option_count"`
+	QuestionTimeLimitMs *int       `json:"question_time_limit_ms,omitempty"`
+	ChallengeDate       *string    `json:"challenge_date,omitempty"` // Daily challenges only, YYYY-MM-DD
 }
 
 // GameQuestionResponse represents a vocabgame question for HTTP response
@@ -93,8 +163,22 @@ type OptionResponse struct {
 // QuestionWithOptions represents a question with its options for the response
 type QuestionWithOptions struct {
 	GameQuestionResponse
-	SourceWordText string          `json:"source_word_text"`
-	Options        []OptionResponse `json:"options"`
+	PromptText     string                `json:"prompt_text"`                // Text shown to the learner, depends on question_type
+	SourceWordText string                `json:"source_word_text,omitempty"` // Only set for 'word_to_translation' questions
+	Hint           *QuestionHintResponse `json:"hint,omitempty"`             // Tested sense of the source word
+	Options        []OptionResponse      `json:"options"`
+
+	// Answer of the learner, only set in GetSession for questions already answered
+	Answered         bool    `json:"answered"`
+	SelectedOptionID *int64  `json:"selected_option_id,omitempty"`
+	AnswerText       *string `json:"answer_text,omitempty"`
+	IsCorrect        *bool   `json:"is_correct,omitempty"`
+}
+
+// QuestionHintResponse describes the sense of the source word tested by a question
+type QuestionHintResponse struct {
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Definition   string `json:"definition,omitempty"`
 }
 
 // GetSessionResponse represents the response for getting a session
@@ -103,7 +187,67 @@ type GetSessionResponse struct {
 	Questions []QuestionWithOptions `json:"questions"`
 }
 
+// NextQuestionResponse represents the next unanswered question of a session and the progress so far
+type NextQuestionResponse struct {
+	Session        GameSessionResponse     `json:"session"`
+	Question       *QuestionWithOptions    `json:"question"` // null when every question is answered or the session has ended
+	Progress       SessionProgressResponse `json:"progress"`
+	AnswerDeadline *time.Time              `json:"answer_deadline,omitempty"` // Only set for time-limited sessions
+}
+
+// SessionProgressResponse represents how far a learner got in a session
+type SessionProgressResponse struct {
+	AnsweredQuestions int `json:"answered_questions"`
+	TotalQuestions    int `json:"total_questions"`
+	CorrectAnswers    int `json:"correct_answers"`
+}
+
+// SessionReviewResponse represents the review of an ended session
+type SessionReviewResponse struct {
+	Session   GameSessionResponse      `json:"session"`
+	Progress  SessionProgressResponse  `json:"progress"`
+	Questions []ReviewQuestionResponse `json:"questions"`
+}
+
+// ReviewQuestionResponse represents a question of an ended session with its correct answer and the learner's answer
+type ReviewQuestionResponse struct {
+	ID                  int64                      `json:"id"`
+	QuestionOrder       int16                      `json:"question_order"`
+	QuestionType        string                     `json:"question_type"`
+	PromptText          string                     `json:"prompt_text"`
+	SourceWordID        int64                      `json:"source_word_id"`
+	SourceWordText      string                     `json:"source_word_text"`
+	CorrectTargetWordID int64                      `json:"correct_target_word_id"`
+	CorrectWordText     string                     `json:"correct_word_text"`
+	Options             []ReviewOptionResponse     `json:"options"`
+	Answered            bool                       `json:"answered"`
+	IsCorrect           bool                       `json:"is_correct"`
+	SelectedOptionID    *int64                     `json:"selected_option_id,omitempty"`
+	AnswerText          *string                    `json:"answer_text,omitempty"`
+	ResponseTimeMs      *int                       `json:"response_time_ms,omitempty"`
+	Explanation         *ReviewExplanationResponse `json:"explanation,omitempty"` // Only set for missed questions
+}
+
+// ReviewOptionResponse represents an option of a reviewed question, revealing is_correct
+type ReviewOptionResponse struct {
+	ID           int64  `json:"id"`
+	OptionLabel  string `json:"option_label"`
+	TargetWordID int64  `json:"target_word_id"`
+	WordText     string `json:"word_text"`
+	IsCorrect    bool   `json:"is_correct"`
+	Selected     bool   `json:"selected"`
+}
+
+// ReviewExplanationResponse describes a missed word with its tested sense, examples and pronunciations
+type ReviewExplanationResponse struct {
+	SenseID        *int64                      `json:"sense_id,omitempty"`
+	PartOfSpeech   *string                     `json:"part_of_speech,omitempty"`
+	Definition     string                      `json:"definition,omitempty"`
+	Examples       []*dictdomain.Example       `json:"examples"`
+	Pronunciations []*dictdomain.Pronunciation `json:"pronunciations"`
+}
+
 // ListSessionsResponse represents the response for listing sessions
 type ListSessionsResponse struct {
 	Sessions []GameSessionResponse `json:"sessions"`
-}
\ No newline at end of file
+}
@@ -1,13 +1,24 @@
 package http
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecompletesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/complete_session"
 	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	gamegetnextquestion "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_next_question"
+	gamegetsessionreview "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_session_review"
+	gameretrymistakes "github.com/english-coach/backend/internal/modules/vocabgame/usecase/retry_mistakes"
+	gamestartdailychallenge "github.com/english-coach/backend/internal/modules/vocabgame/usecase/start_daily_challenge"
 	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
+	gamewatchprogress "github.com/english-coach/backend/internal/modules/vocabgame/usecase/watch_progress"
+	"github.com/english-coach/backend/internal/shared/constants"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
 	"github.com/english-coach/backend/internal/shared/pagination"
@@ -18,30 +29,57 @@ import (
 
 // Handler handles vocabgame-related HTTP requests
 type Handler struct {
-	createSessionUC *gamecreatesession.Handler
-	submitAnswerUC  *gamesubmitanswer.Handler
-	questionRepo    domain.GameQuestionRepository
-	sessionRepo     domain.GameSessionRepository
-	wordRepo        dictdomain.WordRepository
-	logger          logger.ILogger
+	createSessionUC       *gamecreatesession.Handler
+	submitAnswerUC        *gamesubmitanswer.Handler
+	completeSessionUC     *gamecompletesession.Handler
+	getNextQuestionUC     *gamegetnextquestion.Handler
+	getSessionReviewUC    *gamegetsessionreview.Handler
+	retryMistakesUC       *gameretrymistakes.Handler
+	startDailyChallengeUC *gamestartdailychallenge.Handler
+	watchProgressUC       *gamewatchprogress.Handler
+	questionRepo          domain.GameQuestionRepository
+	sessionRepo           domain.GameSessionRepository
+	answerRepo            domain.GameAnswerRepository
+	wordRepo              dictdomain.WordRepository
+	senseRepo             dictdomain.SenseRepository
+	partOfSpeechRepo      dictdomain.PartOfSpeechRepository
+	logger                logger.ILogger
 }
 
 // NewHandler creates a new vocabgame handler
 func NewHandler(
 	createSessionUC *gamecreatesession.Handler,
 	submitAnswerUC *gamesubmitanswer.Handler,
+	completeSessionUC *gamecompletesession.Handler,
+	getNextQuestionUC *gamegetnextquestion.Handler,
+	getSessionReviewUC *gamegetsessionreview.Handler,
+	retryMistakesUC *gameretrymistakes.Handler,
+	startDailyChallengeUC *gamestartdailychallenge.Handler,
+	watchProgressUC *gamewatchprogress.Handler,
 	questionRepo domain.GameQuestionRepository,
 	sessionRepo domain.GameSessionRepository,
+	answerRepo domain.GameAnswerRepository,
 	wordRepo dictdomain.WordRepository,
+	senseRepo dictdomain.SenseRepository,
+	partOfSpeechRepo dictdomain.PartOfSpeechRepository,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
-		createSessionUC: createSessionUC,
-		submitAnswerUC:  submitAnswerUC,
-		questionRepo:    questionRepo,
-		sessionRepo:     sessionRepo,
-		wordRepo:        wordRepo,
-		logger:          logger,
+		createSessionUC:       createSessionUC,
+		submitAnswerUC:        submitAnswerUC,
+		completeSessionUC:     completeSessionUC,
+		getNextQuestionUC:     getNextQuestionUC,
+		getSessionReviewUC:    getSessionReviewUC,
+		retryMistakesUC:       retryMistakesUC,
+		startDailyChallengeUC: startDailyChallengeUC,
+		watchProgressUC:       watchProgressUC,
+		questionRepo:          questionRepo,
+		sessionRepo:           sessionRepo,
+		answerRepo:            answerRepo,
+		wordRepo:              wordRepo,
+		senseRepo:             senseRepo,
+		partOfSpeechRepo:      partOfSpeechRepo,
+		logger:                logger,
 	}
 }
 
@@ -84,11 +122,15 @@ func (h *Handler) CreateSession(c *gin.Context) {
 
 	// Convert to use case input
 	input := gamecreatesession.CreateSessionInput{
-		Mode:             req.Mode,
-		SourceLanguageID: req.SourceLanguageID,
-		TargetLanguageID: req.TargetLanguageID,
-		LevelID:          req.LevelID,
-		TopicIDs:         req.TopicIDs,
+		Mode:                req.Mode,
+		SourceLanguageID:    req.SourceLanguageID,
+		TargetLanguageID:    req.TargetLanguageID,
+		LevelID:             req.LevelID,
+		TopicIDs:            req.TopicIDs,
+		QuestionTypes:       req.QuestionTypes,
+		QuestionCount:       req.QuestionCount,
+		OptionCount:         req.OptionCount,
+		QuestionTimeLimitMs: req.QuestionTimeLimitMs,
 	}
 
 	// Validate request
@@ -131,16 +173,19 @@ func (h *Handler) CreateSession(c *gin.Context) {
 	)
 
 	resp := CreateSessionResponse{
-		ID:               session.ID,
-		UserID:           session.UserID,
-		Mode:             session.Mode,
-		SourceLanguageID: session.SourceLanguageID,
-		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
-		LevelID:          session.LevelID,
-		TotalQuestions:   session.TotalQuestions,
-		CorrectQuestions: session.CorrectQuestions,
-		StartedAt:        session.StartedAt,
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             session.TopicID,
+		LevelID:             session.LevelID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		StartedAt:           session.StartedAt,
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+		ChallengeDate:       formatChallengeDate(session.ChallengeDate),
 	}
 	if session.EndedAt != nil {
 		resp.EndedAt = session.EndedAt
@@ -149,6 +194,270 @@ func (h *Handler) CreateSession(c *gin.Context) {
 	response.Success(c, http.StatusCreated, resp)
 }
 
+// RetryMistakes handles POST /api/v1/vocabgames/sessions/{sessionId}/retry-mistakes
+func (h *Handler) RetryMistakes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ErrorResponse(c, http.StatusBadRequest,
+			"INVALID_PARAMETER",
+			"ID phiên chơi không hợp lệ",
+			nil,
+		)
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	session, err := h.retryMistakesUC.Execute(ctx, gameretrymistakes.RetryMistakesInput{
+		SessionID: req.SessionID,
+		UserID:    userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, CreateSessionResponse{
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             session.TopicID,
+		LevelID:             session.LevelID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		StartedAt:           session.StartedAt,
+		EndedAt:             session.EndedAt,
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+	})
+}
+
+// StartDailyChallenge handles POST /api/v1/vocabgames/daily-challenges
+func (h *Handler) StartDailyChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	var req StartDailyChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidRequest.WithDetails(err.Error()))
+		return
+	}
+
+	session, err := h.startDailyChallengeUC.Execute(ctx, gamestartdailychallenge.StartDailyChallengeInput{
+		SourceLanguageID: req.SourceLanguageID,
+		TargetLanguageID: req.TargetLanguageID,
+		UserID:           userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, CreateSessionResponse{
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		StartedAt:           session.StartedAt,
+		EndedAt:             session.EndedAt,
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+		ChallengeDate:       formatChallengeDate(session.ChallengeDate),
+	})
+}
+
+// GetDailyChallengeLeaderboard handles GET /api/v1/vocabgames/daily-challenges/leaderboard
+func (h *Handler) GetDailyChallengeLeaderboard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req DailyChallengeLeaderboardRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails(err.Error()))
+		return
+	}
+
+	// Leaderboard of today's challenge unless a day is given
+	challengeDate := domain.DailyChallengeDay(time.Now())
+	if req.Date != "" {
+		parsed, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid date"))
+			return
+		}
+		challengeDate = parsed
+	}
+
+	// Parse pagination parameters
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	entries, err := h.sessionRepo.FindDailyChallengeLeaderboard(ctx, challengeDate, req.SourceLanguageID, req.TargetLanguageID, paginationParams.Limit, paginationParams.Offset)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// Get total count for pagination
+	totalCount, err := h.sessionRepo.CountDailyChallengeEntries(ctx, challengeDate, req.SourceLanguageID, req.TargetLanguageID)
+	if err != nil {
+		h.logger.Error("failed to count daily challenge entries",
+			logger.Error(err),
+			logger.String("challenge_date", challengeDate.Format("2006-01-02")),
+		)
+		// Continue without total count
+		totalCount = int64(len(entries))
+	}
+
+	entryResponses := make([]DailyChallengeEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		entryResponses = append(entryResponses, DailyChallengeEntryResponse{
+			Rank:                entry.Rank,
+			SessionID:           entry.SessionID,
+			UserID:              entry.UserID,
+			DisplayName:         entry.DisplayName,
+			CorrectQuestions:    entry.CorrectQuestions,
+			TotalQuestions:      entry.TotalQuestions,
+			TotalResponseTimeMs: entry.TotalResponseTimeMs,
+			EndedAt:             entry.EndedAt,
+		})
+	}
+
+	response.Paginated(c, http.StatusOK, entryResponses, paginationParams, totalCount)
+}
+
+// WatchProgress handles GET /api/v1/vocabgames/progress/events
+// It streams the answers recorded and the sessions ended by the users as Server-Sent Events
+// until the client disconnects.
+func (h *Handler) WatchProgress(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req WatchProgressRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails(err.Error()))
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	// The subscription ends with the request
+	output, err := h.watchProgressUC.Execute(ctx, gamewatchprogress.WatchProgressInput{
+		ViewerID: userIDInt64,
+		UserIDs:  req.UserIDs,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// The stream stays open longer than the write timeout of the server
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Warn("failed to clear write deadline of progress stream",
+			logger.Error(err),
+		)
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disable proxy buffering
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	// Comments keep idle connections from being closed by proxies
+	keepAlive := time.NewTicker(constants.ProgressKeepAliveSeconds * time.Second)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-output.Events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, string(event.Payload))
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
 // ListSessions handles GET /api/v1/vocabgames/sessions
 func (h *Handler) ListSessions(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -223,17 +532,20 @@ func (h *Handler) ListSessions(c *gin.Context) {
 	sessionResponses := make([]GameSessionResponse, 0, len(sessions))
 	for _, session := range sessions {
 		sessionResponses = append(sessionResponses, GameSessionResponse{
-			ID:               session.ID,
-			UserID:           session.UserID,
-			Mode:             session.Mode,
-			SourceLanguageID: session.SourceLanguageID,
-			TargetLanguageID: session.TargetLanguageID,
-			TopicID:          session.TopicID,
-			LevelID:          session.LevelID,
-			TotalQuestions:   session.TotalQuestions,
-			CorrectQuestions: session.CorrectQuestions,
-			StartedAt:        session.StartedAt,
-			EndedAt:          session.EndedAt,
+			ID:                  session.ID,
+			UserID:              session.UserID,
+			Mode:                session.Mode,
+			SourceLanguageID:    session.SourceLanguageID,
+			TargetLanguageID:    session.TargetLanguageID,
+			TopicID:             session.TopicID,
+			LevelID:             session.LevelID,
+			TotalQuestions:      session.TotalQuestions,
+			CorrectQuestions:    session.CorrectQuestions,
+			StartedAt:           session.StartedAt,
+			EndedAt:             session.EndedAt,
+			OptionCount:         session.OptionCount,
+			QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+			ChallengeDate:       formatChallengeDate(session.ChallengeDate),
 		})
 	}
 
@@ -306,6 +618,264 @@ func (h *Handler) GetSession(c *gin.Context) {
 		return
 	}
 
+	// Get answers to mark the questions already answered
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, sessionID, userIDInt64)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	answerMap := make(map[int64]*domain.GameAnswer, len(answers))
+	for _, answer := range answers {
+		answerMap[answer.QuestionID] = answer
+	}
+
+	questionsWithOptions, err := h.buildQuestionResponses(ctx, questions)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	for i := range questionsWithOptions {
+		if answer := answerMap[questionsWithOptions[i].ID]; answer != nil {
+			questionsWithOptions[i].Answered = true
+			questionsWithOptions[i].SelectedOptionID = answer.SelectedOptionID
+			questionsWithOptions[i].AnswerText = answer.AnswerText
+			isCorrect := answer.IsCorrect
+			questionsWithOptions[i].IsCorrect = &isCorrect
+		}
+	}
+
+	response.Success(c, http.StatusOK, GetSessionResponse{
+		Session:   mapSessionToResponse(session),
+		Questions: questionsWithOptions,
+	})
+}
+
+// GetNextQuestion handles GET /api/v1/vocabgames/sessions/{sessionId}/next
+func (h *Handler) GetNextQuestion(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ErrorResponse(c, http.StatusBadRequest,
+			"INVALID_PARAMETER",
+			"ID phiên chơi không hợp lệ",
+			nil,
+		)
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	next, err := h.getNextQuestionUC.Execute(ctx, gamegetnextquestion.GetNextQuestionInput{
+		SessionID: req.SessionID,
+		UserID:    userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	resp := NextQuestionResponse{
+		Session: mapSessionToResponse(next.Session),
+		Progress: SessionProgressResponse{
+			AnsweredQuestions: next.AnsweredQuestions,
+			TotalQuestions:    next.TotalQuestions,
+			CorrectAnswers:    next.CorrectAnswers,
+		},
+		AnswerDeadline: next.AnswerDeadline,
+	}
+	if next.Question != nil {
+		questions, err := h.buildQuestionResponses(ctx, []*domain.GameQuestion{next.Question})
+		if err != nil {
+			middleware.SetError(c, err)
+			return
+		}
+		resp.Question = &questions[0]
+	}
+
+	response.Success(c, http.StatusOK, resp)
+}
+
+// GetSessionReview handles GET /api/v1/vocabgames/sessions/{sessionId}/review
+func (h *Handler) GetSessionReview(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ErrorResponse(c, http.StatusBadRequest,
+			"INVALID_PARAMETER",
+			"ID phiên chơi không hợp lệ",
+			nil,
+		)
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	review, err := h.getSessionReviewUC.Execute(ctx, gamegetsessionreview.GetSessionReviewInput{
+		SessionID: req.SessionID,
+		UserID:    userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	questions := make([]ReviewQuestionResponse, 0, len(review.Questions))
+	for _, rq := range review.Questions {
+		questions = append(questions, mapReviewQuestionToResponse(rq))
+	}
+
+	response.Success(c, http.StatusOK, SessionReviewResponse{
+		Session: mapSessionToResponse(review.Session),
+		Progress: SessionProgressResponse{
+			AnsweredQuestions: review.AnsweredQuestions,
+			TotalQuestions:    len(review.Questions),
+			CorrectAnswers:    review.CorrectAnswers,
+		},
+		Questions: questions,
+	})
+}
+
+// mapReviewQuestionToResponse maps a reviewed question to ReviewQuestionResponse
+func mapReviewQuestionToResponse(rq gamegetsessionreview.ReviewQuestion) ReviewQuestionResponse {
+	q := rq.Question
+	resp := ReviewQuestionResponse{
+		ID:                  q.ID,
+		QuestionOrder:       q.QuestionOrder,
+		QuestionType:        q.QuestionType,
+		SourceWordID:        q.SourceWordID,
+		CorrectTargetWordID: q.CorrectTargetWordID,
+		Options:             make([]ReviewOptionResponse, 0, len(rq.Options)),
+	}
+	if rq.SourceWord != nil {
+		resp.SourceWordText = rq.SourceWord.Lemma
+	}
+	if rq.CorrectWord != nil {
+		resp.CorrectWordText = rq.CorrectWord.Lemma
+	}
+	resp.PromptText = resp.SourceWordText
+	if q.PromptText != nil {
+		resp.PromptText = *q.PromptText
+	}
+
+	for _, opt := range rq.Options {
+		optionResp := ReviewOptionResponse{
+			ID:           opt.Option.ID,
+			OptionLabel:  opt.Option.OptionLabel,
+			TargetWordID: opt.Option.TargetWordID,
+			IsCorrect:    opt.IsCorrect,
+			Selected:     opt.Selected,
+		}
+		if opt.Word != nil {
+			optionResp.WordText = opt.Word.Lemma
+		}
+		resp.Options = append(resp.Options, optionResp)
+	}
+
+	if rq.Answer != nil {
+		resp.Answered = true
+		resp.IsCorrect = rq.Answer.IsCorrect
+		resp.SelectedOptionID = rq.Answer.SelectedOptionID
+		resp.AnswerText = rq.Answer.AnswerText
+		resp.ResponseTimeMs = rq.Answer.ResponseTimeMs
+	}
+
+	if rq.Missed && (rq.Sense != nil || len(rq.Pronunciations) > 0) {
+		explanation := &ReviewExplanationResponse{
+			Examples:       []*dictdomain.Example{},
+			Pronunciations: rq.Pronunciations,
+		}
+		if explanation.Pronunciations == nil {
+			explanation.Pronunciations = []*dictdomain.Pronunciation{}
+		}
+		if rq.Sense != nil {
+			senseID := rq.Sense.ID
+			explanation.SenseID = &senseID
+			explanation.PartOfSpeech = rq.Sense.PartOfSpeechName
+			explanation.Definition = rq.Sense.Definition
+			explanation.Examples = rq.Sense.Examples
+		}
+		resp.Explanation = explanation
+	}
+
+	return resp
+}
+
+// mapSessionToResponse maps a session to GameSessionResponse
+func mapSessionToResponse(session *domain.GameSession) GameSessionResponse {
+	return GameSessionResponse{
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             session.TopicID,
+		LevelID:             session.LevelID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		StartedAt:           session.StartedAt,
+		EndedAt:             session.EndedAt,
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+		ChallengeDate:       formatChallengeDate(session.ChallengeDate),
+	}
+}
+
+// formatChallengeDate formats the day of a daily challenge session, nil for other sessions
+func formatChallengeDate(challengeDate *time.Time) *string {
+	if challengeDate == nil {
+		return nil
+	}
+	formatted := challengeDate.Format("2006-01-02")
+	return &formatted
+}
+
+// buildQuestionResponses loads the words and hints of the questions and maps them to responses
+// with word texts and options without is_correct
+func (h *Handler) buildQuestionResponses(ctx context.Context, questions []*domain.GameQuestion) ([]QuestionWithOptions, error) {
 	// Collect all word IDs (source words and target words in options)
 	wordIDs := make(map[int64]bool)
 	for _, q := range questions {
@@ -324,10 +894,10 @@ func (h *Handler) GetSession(c *gin.Context) {
 	// Fetch words if we have any
 	var words []*dictdomain.Word
 	if len(wordIDList) > 0 {
+		var err error
 		words, err = h.wordRepo.FindWordsByIDs(ctx, wordIDList)
 		if err != nil {
-			middleware.SetError(c, err)
-			return
+			return nil, err
 		}
 	}
 
@@ -337,21 +907,10 @@ func (h *Handler) GetSession(c *gin.Context) {
 		wordMap[word.ID] = word
 	}
 
-	// Map session to response DTO
-	sessionResp := GameSessionResponse{
-		ID:               session.ID,
-		UserID:           session.UserID,
-		Mode:             session.Mode,
-		SourceLanguageID: session.SourceLanguageID,
-		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
-		LevelID:          session.LevelID,
-		TotalQuestions:   session.TotalQuestions,
-		CorrectQuestions: session.CorrectQuestions,
-		StartedAt:        session.StartedAt,
-	}
-	if session.EndedAt != nil {
-		sessionResp.EndedAt = session.EndedAt
+	// Load the tested senses to show their part of speech and definition as hints
+	hints, err := h.loadQuestionHints(ctx, questions)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build response with word text and options without is_correct
@@ -364,6 +923,15 @@ func (h *Handler) GetSession(c *gin.Context) {
 			sourceWordText = sourceWord.Lemma
 		}
 
+		// Questions asking for the source word show their prompt instead and must not reveal the word
+		promptText := sourceWordText
+		if q.PromptText != nil {
+			promptText = *q.PromptText
+		}
+		if domain.AnswersWithSourceWord(q.QuestionType) {
+			sourceWordText = ""
+		}
+
 		// Build options WITHOUT is_correct (for security)
 		optionResponses := make([]OptionResponse, 0, len(q.Options))
 		for _, opt := range q.Options {
@@ -396,15 +964,85 @@ func (h *Handler) GetSession(c *gin.Context) {
 				TargetLanguageID:    q.TargetLanguageID,
 				CreatedAt:           q.CreatedAt,
 			},
+			PromptText:     promptText,
 			SourceWordText: sourceWordText,
+			Hint:           questionHint(q, hints[q.ID]),
 			Options:        optionResponses,
 		})
 	}
 
-	response.Success(c, http.StatusOK, GetSessionResponse{
-		Session:   sessionResp,
-		Questions: questionsWithOptions,
-	})
+	return questionsWithOptions, nil
+}
+
+// questionHintSource is the tested sense of a question and its part of speech
+type questionHintSource struct {
+	sense        *dictdomain.Sense
+	partOfSpeech *dictdomain.PartOfSpeech
+}
+
+// loadQuestionHints loads the tested sense of each question, keyed by question ID
+func (h *Handler) loadQuestionHints(ctx context.Context, questions []*domain.GameQuestion) (map[int64]questionHintSource, error) {
+	hints := make(map[int64]questionHintSource)
+
+	wordIDs := make([]int64, 0, len(questions))
+	for _, q := range questions {
+		if q.SourceSenseID != nil {
+			wordIDs = append(wordIDs, q.SourceWordID)
+		}
+	}
+	if len(wordIDs) == 0 {
+		return hints, nil
+	}
+
+	sensesByWord, err := h.senseRepo.FindSensesByWordIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	senseMap := make(map[int64]*dictdomain.Sense)
+	posIDs := make([]int16, 0)
+	for _, senses := range sensesByWord {
+		for _, sense := range senses {
+			senseMap[sense.ID] = sense
+			posIDs = append(posIDs, sense.PartOfSpeechID)
+		}
+	}
+
+	posMap, err := h.partOfSpeechRepo.FindPartsOfSpeechByIDs(ctx, posIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, q := range questions {
+		if q.SourceSenseID == nil {
+			continue
+		}
+		if sense := senseMap[*q.SourceSenseID]; sense != nil {
+			hints[q.ID] = questionHintSource{
+				sense:        sense,
+				partOfSpeech: posMap[sense.PartOfSpeechID],
+			}
+		}
+	}
+
+	return hints, nil
+}
+
+// questionHint maps the tested sense of a question to its hint.
+// The definition is left out of 'definition_to_word' questions, where it already is the prompt.
+func questionHint(q *domain.GameQuestion, source questionHintSource) *QuestionHintResponse {
+	if source.sense == nil {
+		return nil
+	}
+
+	hint := &QuestionHintResponse{}
+	if source.partOfSpeech != nil {
+		hint.PartOfSpeech = source.partOfSpeech.Name
+	}
+	if q.QuestionType != domain.QuestionTypeDefinitionToWord {
+		hint.Definition = source.sense.Definition
+	}
+	return hint
 }
 
 // SubmitAnswer handles POST /api/v1/vocabgames/sessions/{sessionId}/answers
@@ -456,6 +1094,7 @@ func (h *Handler) SubmitAnswer(c *gin.Context) {
 	input := gamesubmitanswer.SubmitAnswerInput{
 		QuestionID:       req.QuestionID,
 		SelectedOptionID: req.SelectedOptionID,
+		AnswerText:       req.AnswerText,
 		ResponseTimeMs:   req.ResponseTimeMs,
 	}
 
@@ -474,8 +1113,112 @@ func (h *Handler) SubmitAnswer(c *gin.Context) {
 		SelectedOptionID: answer.SelectedOptionID,
 		IsCorrect:        answer.IsCorrect,
 		ResponseTimeMs:   answer.ResponseTimeMs,
+		AnswerText:       answer.AnswerText,
+		MatchResult:      answer.MatchResult,
+		ExpectedAnswer:   answer.ExpectedAnswer,
 		AnsweredAt:       answer.AnsweredAt,
+		SessionCompleted: answer.SessionCompleted,
+		NextReviewAt:     answer.NextReviewAt,
+		XPEarned:         answer.XPEarned,
+		NewAchievements:  mapEarnedAchievementsToResponse(answer.NewAchievements),
+	}
+	if answer.Summary != nil {
+		resp.Summary = mapSummaryToResponse(answer.Summary)
 	}
 
 	response.Success(c, http.StatusCreated, resp)
 }
+
+// CompleteSession handles POST /api/v1/vocabgames/sessions/{sessionId}/complete
+func (h *Handler) CompleteSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ErrorResponse(c, http.StatusBadRequest,
+			"INVALID_PARAMETER",
+			"ID phiên chơi không hợp lệ",
+			nil,
+		)
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	summary, err := h.completeSessionUC.Execute(ctx, gamecompletesession.CompleteSessionInput{
+		SessionID: req.SessionID,
+		UserID:    userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, mapSummaryToResponse(summary))
+}
+
+// mapSummaryToResponse maps a completion summary to SessionSummaryResponse
+func mapSummaryToResponse(summary *gamecompletesession.CompleteSessionOutput) *SessionSummaryResponse {
+	endedAt := summary.EndedAt
+	return &SessionSummaryResponse{
+		Session: GameSessionResponse{
+			ID:                  summary.ID,
+			UserID:              summary.UserID,
+			Mode:                summary.Mode,
+			SourceLanguageID:    summary.SourceLanguageID,
+			TargetLanguageID:    summary.TargetLanguageID,
+			TopicID:             summary.TopicID,
+			LevelID:             summary.LevelID,
+			TotalQuestions:      summary.TotalQuestions,
+			CorrectQuestions:    summary.CorrectQuestions,
+			StartedAt:           summary.StartedAt,
+			EndedAt:             &endedAt,
+			OptionCount:         summary.OptionCount,
+			QuestionTimeLimitMs: summary.QuestionTimeLimitMs,
+		},
+		AnsweredQuestions:   summary.AnsweredQuestions,
+		WrongAnswers:        summary.WrongAnswers,
+		UnansweredQuestions: summary.UnansweredQuestions,
+		AccuracyPercentage:  summary.AccuracyPercentage,
+		DurationSeconds:     summary.DurationSeconds,
+		NewAchievements:     mapEarnedAchievementsToResponse(summary.NewAchievements),
+	}
+}
+
+// mapEarnedAchievementsToResponse maps just earned achievements to EarnedAchievementResponse
+func mapEarnedAchievementsToResponse(earned []statsawardachievements.EarnedAchievement) []EarnedAchievementResponse {
+	if len(earned) == 0 {
+		return nil
+	}
+	items := make([]EarnedAchievementResponse, len(earned))
+	for i, e := range earned {
+		items[i] = EarnedAchievementResponse{
+			Code:        e.Achievement.Code,
+			Name:        e.Achievement.Name,
+			Description: e.Achievement.Description,
+			EarnedAt:    e.EarnedAt,
+		}
+	}
+	return items
+}
@@ -15,7 +15,22 @@ func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware gi
 			sessionsGroup.POST("", handler.CreateSession)
 			sessionsGroup.GET("", handler.ListSessions) // Must be before /:sessionId to avoid route conflict
 			sessionsGroup.GET("/:sessionId", handler.GetSession)
+			sessionsGroup.GET("/:sessionId/next", handler.GetNextQuestion)
+			sessionsGroup.GET("/:sessionId/review", handler.GetSessionReview)
 			sessionsGroup.POST("/:sessionId/answers", handler.SubmitAnswer)
+			sessionsGroup.POST("/:sessionId/complete", handler.CompleteSession)
+			sessionsGroup.POST("/:sessionId/retry-mistakes", handler.RetryMistakes)
+		}
+
+		dailyChallengesGroup := vocabGameGroup.Group("/daily-challenges")
+		{
+			dailyChallengesGroup.POST("", handler.StartDailyChallenge)
+			dailyChallengesGroup.GET("/leaderboard", handler.GetDailyChallengeLeaderboard)
+		}
+
+		progressGroup := vocabGameGroup.Group("/progress")
+		{
+			progressGroup.GET("/events", handler.WatchProgress) // Server-Sent Events stream
 		}
 	}
 }
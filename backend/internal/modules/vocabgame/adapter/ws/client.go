@@ -0,0 +1,134 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+const (
+	// readWait is the time allowed between two messages of a client; clients send a ping to stay connected
+	readWait = 60 * time.Second
+	// writeWait is the time allowed to write a message to a client
+	writeWait = 10 * time.Second
+	// sendBufferSize is the number of messages queued for a client before it is considered too slow and dropped
+	sendBufferSize = 32
+)
+
+// client is the connection of a player
+type client struct {
+	conn     *websocket.Conn
+	userID   int64
+	username string
+	logger   logger.ILogger
+
+	mu     sync.Mutex
+	send   chan []byte
+	closed bool
+	room   *room // Room the player is in, guarded by the hub
+}
+
+func newClient(conn *websocket.Conn, userID int64, username string, log logger.ILogger) *client {
+	return &client{
+		conn:     conn,
+		userID:   userID,
+		username: username,
+		logger:   log,
+		send:     make(chan []byte, sendBufferSize),
+	}
+}
+
+// sendMessage queues a message for the client; clients too slow to keep up are disconnected
+func (c *client) sendMessage(msgType string, data interface{}) {
+	payload, err := json.Marshal(OutboundMessage{Type: msgType, Data: data})
+	if err != nil {
+		c.logger.Error("failed to encode duel message",
+			logger.Error(err),
+			logger.String("type", msgType),
+		)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	select {
+	case c.send <- payload:
+	default:
+		c.closeLocked()
+	}
+}
+
+// sendError sends an error to the client, errors other than AppError are sent as internal errors
+func (c *client) sendError(err error) {
+	appErr, ok := sharederrors.IsAppError(err)
+	if !ok {
+		appErr = sharederrors.ErrInternalError
+	}
+	c.sendMessage(MessageError, ErrorData{
+		Code:     appErr.Code,
+		Message:  appErr.Message,
+		Metadata: appErr.Metadata,
+	})
+}
+
+// close stops sending to the client, the write pump then closes the connection
+func (c *client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closeLocked()
+}
+
+func (c *client) closeLocked() {
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
+
+// writePump writes the queued messages to the connection until the client is closed
+func (c *client) writePump() {
+	defer c.conn.Close()
+	for payload := range c.send {
+		if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+			return
+		}
+		if err := websocket.Message.Send(c.conn, string(payload)); err != nil {
+			return
+		}
+	}
+}
+
+// readPump reads the messages of the client and dispatches them to the hub until the connection is closed
+func (c *client) readPump(h *Hub) {
+	defer func() {
+		h.leave(c)
+		c.close()
+	}()
+
+	for {
+		if err := c.conn.SetReadDeadline(time.Now().Add(readWait)); err != nil {
+			return
+		}
+		var msg InboundMessage
+		if err := websocket.JSON.Receive(c.conn, &msg); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+				// The frame was read, only its content is invalid
+				c.sendError(sharederrors.ErrInvalidRequest.WithDetails("Tin nhắn phải là JSON dạng {\"type\": ..., \"data\": ...}"))
+				continue
+			}
+			return
+		}
+		h.dispatch(c, msg)
+	}
+}
@@ -0,0 +1,98 @@
+package ws
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/english-coach/backend/internal/shared/auth"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+)
+
+// maxMessageBytes is the largest message accepted from a client
+const maxMessageBytes = 4096
+
+// Handler upgrades duel requests to WebSocket connections
+type Handler struct {
+	hub            *Hub
+	jwtManager     *auth.JWTManager
+	allowedOrigins map[string]bool
+	logger         logger.ILogger
+}
+
+// NewHandler creates a new duel WebSocket handler
+func NewHandler(
+	hub *Hub,
+	jwtManager *auth.JWTManager,
+	allowedOrigins []string,
+	logger logger.ILogger,
+) *Handler {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[origin] = true
+	}
+	return &Handler{
+		hub:            hub,
+		jwtManager:     jwtManager,
+		allowedOrigins: origins,
+		logger:         logger,
+	}
+}
+
+// ServeDuel handles GET /api/v1/vocabgames/duels/ws
+// Browsers cannot set headers on WebSocket requests, so the access token is read from the
+// token query parameter, or from the Authorization header for other clients.
+func (h *Handler) ServeDuel(c *gin.Context) {
+	claims, err := h.jwtManager.ValidateToken(accessToken(c))
+	if err != nil {
+		code := "UNAUTHORIZED"
+		if err == auth.ErrExpiredToken {
+			code = "TOKEN_EXPIRED"
+		}
+		c.JSON(http.StatusUnauthorized, response.NewError(code, err.Error(), nil))
+		c.Abort()
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: h.checkOrigin,
+		Handler: func(conn *websocket.Conn) {
+			// The connection inherits the read and write timeouts of the HTTP server, the pumps set their own
+			if err := conn.SetDeadline(time.Time{}); err != nil {
+				return
+			}
+			conn.MaxPayloadBytes = maxMessageBytes
+
+			client := newClient(conn, claims.UserID, claims.Username, h.logger)
+			go client.writePump()
+			client.readPump(h.hub)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// checkOrigin accepts requests from the allowed CORS origins, and requests without an origin from non-browser clients
+func (h *Handler) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" || h.allowedOrigins["*"] || h.allowedOrigins[origin] {
+		return nil
+	}
+	return errors.New("origin not allowed: " + origin)
+}
+
+// accessToken returns the access token of the request
+func accessToken(c *gin.Context) string {
+	if token := c.Query("token"); token != "" {
+		return token
+	}
+	parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+	if len(parts) == 2 && parts[0] == "Bearer" {
+		return parts[1]
+	}
+	return ""
+}
@@ -0,0 +1,253 @@
+package ws
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamefinishduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/finish_duel"
+	gamestartduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/start_duel"
+	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+const (
+	// roomCodeAlphabet leaves out characters easily mistaken for one another when read aloud in a classroom
+	roomCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	roomCodeLength   = 6
+)
+
+// Hub keeps the duel rooms in memory and dispatches the messages of the players to them.
+// A player is in at most one room at a time.
+type Hub struct {
+	startDuelUC    *gamestartduel.Handler
+	submitAnswerUC *gamesubmitanswer.Handler
+	finishDuelUC   *gamefinishduel.Handler
+	logger         logger.ILogger
+
+	// mu guards rooms and the room of every client; it is always taken before the lock of a room
+	// and matchmaking reads the snapshots of the rooms instead of their locks
+	mu    sync.Mutex
+	rooms map[string]*room
+}
+
+// NewHub creates a new duel hub
+func NewHub(
+	startDuelUC *gamestartduel.Handler,
+	submitAnswerUC *gamesubmitanswer.Handler,
+	finishDuelUC *gamefinishduel.Handler,
+	logger logger.ILogger,
+) *Hub {
+	return &Hub{
+		startDuelUC:    startDuelUC,
+		submitAnswerUC: submitAnswerUC,
+		finishDuelUC:   finishDuelUC,
+		logger:         logger,
+		rooms:          make(map[string]*room),
+	}
+}
+
+// dispatch handles a message of a client
+func (h *Hub) dispatch(c *client, msg InboundMessage) {
+	var err error
+	switch msg.Type {
+	case MessagePing:
+		c.sendMessage(MessagePong, nil)
+	case MessageCreateRoom:
+		var settings DuelSettings
+		if err = decodeData(msg.Data, &settings); err == nil {
+			err = h.createRoom(c, settings)
+		}
+	case MessageJoinRoom:
+		var data JoinRoomData
+		if err = decodeData(msg.Data, &data); err == nil {
+			err = h.joinRoom(c, data.RoomCode)
+		}
+	case MessageFindMatch:
+		var settings DuelSettings
+		if err = decodeData(msg.Data, &settings); err == nil {
+			err = h.findMatch(c, settings)
+		}
+	case MessageStart:
+		if r := h.roomOf(c); r != nil {
+			err = r.start(c)
+		} else {
+			err = sharederrors.MapDomainErrorToAppError(domain.ErrDuelRoomNotFound)
+		}
+	case MessageAnswer:
+		var data AnswerData
+		if err = decodeData(msg.Data, &data); err == nil {
+			err = h.answer(c, data)
+		}
+	case MessageLeave:
+		h.leave(c)
+	default:
+		err = sharederrors.ErrInvalidRequest.WithDetails("Loại tin nhắn không được hỗ trợ: " + msg.Type)
+	}
+
+	if err != nil {
+		c.sendError(err)
+	}
+}
+
+// decodeData decodes the data of a message
+func decodeData(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 {
+		return sharederrors.ErrInvalidRequest.WithDetails("Thiếu dữ liệu của tin nhắn")
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return sharederrors.ErrInvalidRequest.WithDetails(err.Error())
+	}
+	return nil
+}
+
+// createRoom creates a private room hosted by the client, joined by sharing its code
+func (h *Hub) createRoom(c *client, settings DuelSettings) error {
+	h.leave(c)
+
+	h.mu.Lock()
+	r := newRoom(h, h.newRoomCode(), settings, false)
+	h.rooms[r.code] = r
+	h.mu.Unlock()
+
+	h.logger.Info("duel room created",
+		logger.String("room_code", r.code),
+		logger.Int64("user_id", c.userID),
+	)
+	return h.enter(c, r)
+}
+
+// joinRoom adds the client to the room of a code
+func (h *Hub) joinRoom(c *client, code string) error {
+	h.mu.Lock()
+	r, ok := h.rooms[code]
+	h.mu.Unlock()
+	if !ok {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelRoomNotFound)
+	}
+
+	if h.roomOf(c) != r {
+		h.leave(c)
+	}
+	return h.enter(c, r)
+}
+
+// findMatch adds the client to an open matchmaking room with the same settings, or opens one.
+// Matchmaking rooms start as soon as enough players joined.
+func (h *Hub) findMatch(c *client, settings DuelSettings) error {
+	h.leave(c)
+
+	h.mu.Lock()
+	var match *room
+	for _, r := range h.rooms {
+		if r.public && reflect.DeepEqual(r.settings, settings) && r.isOpen() {
+			match = r
+			break
+		}
+	}
+	if match == nil {
+		match = newRoom(h, h.newRoomCode(), settings, true)
+		h.rooms[match.code] = match
+	}
+	h.mu.Unlock()
+
+	err := h.enter(c, match)
+	if err != nil && match.isOpen() {
+		return err
+	}
+	if err != nil {
+		// The room filled up or started after its snapshot was read
+		h.mu.Lock()
+		match = newRoom(h, h.newRoomCode(), settings, true)
+		h.rooms[match.code] = match
+		h.mu.Unlock()
+		if err := h.enter(c, match); err != nil {
+			return err
+		}
+	}
+	match.startIfReady()
+	return nil
+}
+
+// answer submits an answer of the client in their room
+func (h *Hub) answer(c *client, data AnswerData) error {
+	r := h.roomOf(c)
+	if r == nil {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelRoomNotFound)
+	}
+	done, err := r.answer(c, data)
+	if done {
+		h.endRoom(r)
+	}
+	return err
+}
+
+// enter joins the client to a room and records it as the room of the client
+func (h *Hub) enter(c *client, r *room) error {
+	if err := r.join(c); err != nil {
+		return err
+	}
+	h.mu.Lock()
+	c.room = r
+	h.mu.Unlock()
+	return nil
+}
+
+// leave removes the client from their room, if any
+func (h *Hub) leave(c *client) {
+	h.mu.Lock()
+	r := c.room
+	c.room = nil
+	h.mu.Unlock()
+
+	if r != nil && r.leave(c) {
+		h.endRoom(r)
+	}
+}
+
+// endRoom sends the result of an ended room to its players and forgets it
+func (h *Hub) endRoom(r *room) {
+	r.finish()
+	h.removeRoom(r)
+}
+
+// removeRoom forgets an ended room
+func (h *Hub) removeRoom(r *room) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.rooms[r.code] == r {
+		delete(h.rooms, r.code)
+	}
+	r.mu.Lock()
+	for _, p := range r.players {
+		if p.client.room == r {
+			p.client.room = nil
+		}
+	}
+	r.mu.Unlock()
+}
+
+func (h *Hub) roomOf(c *client) *room {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return c.room
+}
+
+// newRoomCode returns a code no open room uses; h.mu must be held
+func (h *Hub) newRoomCode() string {
+	for {
+		// The alphabet has 32 characters, so every byte maps to a character uniformly
+		code := make([]byte, roomCodeLength)
+		rand.Read(code)
+		for i, b := range code {
+			code[i] = roomCodeAlphabet[int(b)%len(roomCodeAlphabet)]
+		}
+		if _, ok := h.rooms[string(code)]; !ok {
+			return string(code)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package ws
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Message types sent by clients
+const (
+	MessageCreateRoom = "create_room"
+	MessageJoinRoom   = "join_room"
+	MessageFindMatch  = "find_match"
+	MessageStart      = "start"
+	MessageAnswer     = "answer"
+	MessageLeave      = "leave"
+	MessagePing       = "ping"
+)
+
+// Message types sent by the server
+const (
+	MessageRoomState    = "room_state"
+	MessageDuelStarted  = "duel_started"
+	MessageQuestion     = "question"
+	MessageAnswerResult = "answer_result"
+	MessagePoint        = "point"
+	MessageDuelEnded    = "duel_ended"
+	MessageError        = "error"
+	MessagePong         = "pong"
+)
+
+// InboundMessage represents a message sent by a client, data depends on the type
+type InboundMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// OutboundMessage represents a message sent by the server, data depends on the type
+type OutboundMessage struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data,omitempty"`
+}
+
+// DuelSettings represents the game settings of a room, sent with create_room and find_match
+type DuelSettings struct {
+	SourceLanguageID int16    `json:"source_language_id"`
+	TargetLanguageID int16    `json:"target_language_id"`
+	Mode             string   `json:"mode"`                     // 'level', 'topic' or 'mixed'
+	LevelID          int64    `json:"level_id,omitempty"`       // Required for 'level' mode
+	TopicIDs         []int64  `json:"topic_ids,omitempty"`      // Required for 'topic' mode
+	QuestionTypes    []string `json:"question_types,omitempty"` // Optional, default 'word_to_translation'
+	QuestionCount    int      `json:"question_count,omitempty"` // Optional, default 10
+	OptionCount      int      `json:"option_count,omitempty"`   // Optional, default 4
+}
+
+// JoinRoomData represents the data of a join_room message
+type JoinRoomData struct {
+	RoomCode string `json:"room_code"`
+}
+
+// AnswerData represents the data of an answer message
+type AnswerData struct {
+	QuestionOrder    int16   `json:"question_order"`
+	SelectedOptionID *int64  `json:"selected_option_id,omitempty"` // Required for multiple-choice questions
+	AnswerText       *string `json:"answer_text,omitempty"`        // Required for typed questions
+	ResponseTimeMs   *int    `json:"response_time_ms,omitempty"`
+}
+
+// PlayerState represents a player of a room with their score
+type PlayerState struct {
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	Points    int16  `json:"points"`
+	Connected bool   `json:"connected"`
+}
+
+// RoomStateData represents the data of a room_state message, sent whenever players join, leave or score
+type RoomStateData struct {
+	RoomCode   string        `json:"room_code"`
+	HostUserID int64         `json:"host_user_id"`
+	Status     string        `json:"status"` // 'waiting', 'starting', 'playing' or 'ended'
+	Settings   DuelSettings  `json:"settings"`
+	Players    []PlayerState `json:"players"`
+}
+
+// DuelStartedData represents the data of a duel_started message.
+// Each player gets the session they answer in, whose questions are fetched with GET /vocabgames/sessions/{id}.
+type DuelStartedData struct {
+	DuelID         int64 `json:"duel_id"`
+	SessionID      int64 `json:"session_id"`
+	TotalQuestions int   `json:"total_questions"`
+	RoundTimeoutMs int   `json:"round_timeout_ms"`
+}
+
+// QuestionData represents the data of a question message, opening the round of a question
+type QuestionData struct {
+	QuestionOrder int16     `json:"question_order"`
+	QuestionID    int64     `json:"question_id"` // Question of the session of the receiving player
+	ClosesAt      time.Time `json:"closes_at"`
+}
+
+// AnswerResultData represents the data of an answer_result message, sent to the answering player only
+type AnswerResultData struct {
+	QuestionOrder  int16   `json:"question_order"`
+	IsCorrect      bool    `json:"is_correct"`
+	MatchResult    *string `json:"match_result,omitempty"`    // Typed questions only
+	ExpectedAnswer *string `json:"expected_answer,omitempty"` // Typed questions only
+	XPEarned       int     `json:"xp_earned"`
+	WonPoint       bool    `json:"won_point"` // Whether the answer was the first correct one of the round
+}
+
+// PointData represents the data of a point message, broadcast when a player answers a question correctly first
+type PointData struct {
+	QuestionOrder int16         `json:"question_order"`
+	UserID        int64         `json:"user_id"`
+	Players       []PlayerState `json:"players"`
+}
+
+// DuelResultPlayer represents a player of an ended duel
+type DuelResultPlayer struct {
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	SessionID int64  `json:"session_id"`
+	Points    int16  `json:"points"`
+	Rank      int    `json:"rank"`
+}
+
+// DuelEndedData represents the data of a duel_ended message
+type DuelEndedData struct {
+	DuelID  int64              `json:"duel_id"`
+	Players []DuelResultPlayer `json:"players"` // Ranked, the winners first
+}
+
+// ErrorData represents the data of an error message
+type ErrorData struct {
+	Code     string                 `json:"code"`
+	Message  string                 `json:"message"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
@@ -0,0 +1,584 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamefinishduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/finish_duel"
+	gamestartduel "github.com/english-coach/backend/internal/modules/vocabgame/usecase/start_duel"
+	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Room statuses
+const (
+	roomWaiting  = "waiting"
+	roomStarting = "starting" // The sessions of the duel are being created
+	roomPlaying  = "playing"
+	roomEnded    = "ended"
+)
+
+// roomSnapshot is the status and player count of a room, readable without the lock of the room
+type roomSnapshot struct {
+	status  string
+	players int
+}
+
+// roomPlayer is a player of a room
+type roomPlayer struct {
+	client    *client
+	userID    int64
+	username  string
+	sessionID int64 // Set when the duel starts
+	points    int16
+	connected bool
+}
+
+// room is a duel room; players join it while it waits, then answer the same questions round by round.
+// Methods returning done report that the room has ended and must be ended by the caller with hub.endRoom.
+// The lock of the room is never held while a use case runs: the inputs are copied, the lock released
+// during the call and taken again to apply the result.
+type room struct {
+	hub      *Hub
+	code     string
+	settings DuelSettings
+	public   bool // Open to matchmaking, started as soon as enough players joined
+
+	// snapshot is stored whenever status or players change, so that matchmaking never waits on mu
+	snapshot atomic.Pointer[roomSnapshot]
+
+	mu        sync.Mutex
+	status    string
+	hostID    int64
+	players   []*roomPlayer // In join order
+	duel      *domain.Duel
+	questions map[int64][]*domain.GameQuestion
+	round     int            // Index of the open question
+	roundWon  bool           // Whether a player already answered the open question correctly
+	answered  map[int64]bool // Players who answered the open question
+	pending   map[int64]bool // Players whose answer to the open question is being submitted
+	closesAt  time.Time      // Time the open question closes
+	timer     *time.Timer
+}
+
+func newRoom(h *Hub, code string, settings DuelSettings, public bool) *room {
+	r := &room{
+		hub:      h,
+		code:     code,
+		settings: settings,
+		public:   public,
+		status:   roomWaiting,
+	}
+	r.storeSnapshotLocked()
+	return r
+}
+
+// useCaseContext returns the context of the use cases run by the room, which outlives the requests of its players
+func useCaseContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), constants.DefaultAPITimeout*time.Millisecond)
+}
+
+// isOpen reports whether players can join the room, from its snapshot; join has the final say
+func (r *room) isOpen() bool {
+	snapshot := r.snapshot.Load()
+	return snapshot.status == roomWaiting && snapshot.players < constants.DuelMaxPlayers
+}
+
+// storeSnapshotLocked publishes the status and player count of the room
+func (r *room) storeSnapshotLocked() {
+	r.snapshot.Store(&roomSnapshot{status: r.status, players: len(r.players)})
+}
+
+// join adds the client to the room; a player joining again, e.g. after a reconnect, takes their place back
+func (r *room) join(c *client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.storeSnapshotLocked()
+
+	if r.status == roomEnded {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelRoomNotFound)
+	}
+
+	if p := r.playerByUserID(c.userID); p != nil {
+		p.client = c
+		p.connected = true
+		r.broadcastStateLocked()
+		if r.status == roomPlaying {
+			r.sendDuelStartedLocked(p)
+			r.sendQuestionLocked(p)
+		}
+		return nil
+	}
+
+	if r.status != roomWaiting {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelAlreadyStarted)
+	}
+	if len(r.players) >= constants.DuelMaxPlayers {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelRoomFull)
+	}
+
+	r.players = append(r.players, &roomPlayer{
+		client:    c,
+		userID:    c.userID,
+		username:  c.username,
+		connected: true,
+	})
+	if r.hostID == 0 {
+		r.hostID = c.userID
+	}
+	r.broadcastStateLocked()
+	return nil
+}
+
+// leave removes the client from the room. Players leaving a duel in play keep their points,
+// the duel ends when nobody is left to play it.
+func (r *room) leave(c *client) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.storeSnapshotLocked()
+
+	p := r.playerByUserID(c.userID)
+	if p == nil || p.client != c {
+		return false
+	}
+
+	switch r.status {
+	case roomWaiting:
+		for i, player := range r.players {
+			if player == p {
+				r.players = append(r.players[:i], r.players[i+1:]...)
+				break
+			}
+		}
+		if len(r.players) == 0 {
+			r.status = roomEnded
+			return true
+		}
+		if r.hostID == p.userID {
+			r.hostID = r.players[0].userID
+		}
+		r.broadcastStateLocked()
+		return false
+	case roomStarting:
+		// The player still gets a session; the duel ends once started if nobody is left
+		p.connected = false
+		r.broadcastStateLocked()
+		return false
+	case roomPlaying:
+		p.connected = false
+		r.broadcastStateLocked()
+		if r.connectedCount() == 0 {
+			return r.finishLocked()
+		}
+		if r.allAnsweredLocked() {
+			return r.advanceLocked()
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// start starts the duel on request of the host
+func (r *room) start(c *client) error {
+	r.mu.Lock()
+	if r.status != roomWaiting {
+		r.mu.Unlock()
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelAlreadyStarted)
+	}
+	if r.hostID != c.userID {
+		r.mu.Unlock()
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelNotHost)
+	}
+	if len(r.players) < constants.DuelMinPlayers {
+		r.mu.Unlock()
+		return sharederrors.MapDomainErrorToAppError(domain.ErrDuelNotEnoughPlayers)
+	}
+	input := r.beginStartLocked()
+	r.mu.Unlock()
+
+	return r.runStart(input)
+}
+
+// startIfReady starts a matchmaking room once enough players joined it.
+// Failures are sent to every player, the room keeps waiting.
+func (r *room) startIfReady() {
+	r.mu.Lock()
+	if !r.public || r.status != roomWaiting || len(r.players) < constants.DuelMinPlayers {
+		r.mu.Unlock()
+		return
+	}
+	input := r.beginStartLocked()
+	r.mu.Unlock()
+
+	if err := r.runStart(input); err != nil {
+		r.mu.Lock()
+		for _, p := range r.players {
+			p.client.sendError(err)
+		}
+		r.mu.Unlock()
+	}
+}
+
+// beginStartLocked marks the room as starting, so that nobody else joins or starts it,
+// and returns the input of the duel
+func (r *room) beginStartLocked() gamestartduel.StartDuelInput {
+	r.status = roomStarting
+	r.storeSnapshotLocked()
+
+	userIDs := make([]int64, 0, len(r.players))
+	for _, p := range r.players {
+		userIDs = append(userIDs, p.userID)
+	}
+	return gamestartduel.StartDuelInput{
+		RoomCode:         r.code,
+		UserIDs:          userIDs,
+		SourceLanguageID: r.settings.SourceLanguageID,
+		TargetLanguageID: r.settings.TargetLanguageID,
+		Mode:             r.settings.Mode,
+		LevelID:          r.settings.LevelID,
+		TopicIDs:         r.settings.TopicIDs,
+		QuestionTypes:    r.settings.QuestionTypes,
+		QuestionCount:    r.settings.QuestionCount,
+		OptionCount:      r.settings.OptionCount,
+	}
+}
+
+// runStart creates the duel outside the lock of the room, then opens its first question.
+// When the duel cannot be created the room waits again.
+func (r *room) runStart(input gamestartduel.StartDuelInput) error {
+	ctx, cancel := useCaseContext()
+	defer cancel()
+	output, err := r.hub.startDuelUC.Execute(ctx, input)
+
+	r.mu.Lock()
+	if err != nil {
+		r.status = roomWaiting
+		r.storeSnapshotLocked()
+		r.broadcastStateLocked()
+		r.mu.Unlock()
+		return err
+	}
+
+	r.duel = output.Duel
+	r.questions = output.Questions
+	r.status = roomPlaying
+	r.storeSnapshotLocked()
+	for _, dp := range r.duel.Players {
+		if p := r.playerByUserID(dp.UserID); p != nil {
+			p.sessionID = dp.SessionID
+		}
+	}
+
+	for _, p := range r.players {
+		if p.connected {
+			r.sendDuelStartedLocked(p)
+		}
+	}
+	r.broadcastStateLocked()
+
+	// Everybody left while the duel was being created
+	if r.connectedCount() == 0 {
+		done := r.finishLocked()
+		r.mu.Unlock()
+		if done {
+			r.hub.endRoom(r)
+		}
+		return nil
+	}
+
+	r.openRoundLocked(0)
+	r.mu.Unlock()
+	return nil
+}
+
+// answer submits the answer of a player to the open question.
+// The first correct answer wins the point and closes the question.
+func (r *room) answer(c *client, data AnswerData) (bool, error) {
+	r.mu.Lock()
+	p := r.playerByUserID(c.userID)
+	if r.status != roomPlaying || p == nil || p.client != c {
+		r.mu.Unlock()
+		return false, sharederrors.ErrInvalidRequest.WithDetails("Trận đấu chưa bắt đầu hoặc đã kết thúc")
+	}
+
+	round := r.round
+	question := r.questionLocked(p.userID, round)
+	if question == nil || data.QuestionOrder != question.QuestionOrder {
+		r.mu.Unlock()
+		return false, sharederrors.ErrInvalidRequest.WithDetails("Câu hỏi này không còn mở")
+	}
+	if r.answered[p.userID] || r.pending[p.userID] {
+		r.mu.Unlock()
+		return false, sharederrors.MapDomainErrorToAppError(domain.ErrAnswerAlreadySubmitted)
+	}
+	r.pending[p.userID] = true
+	sessionID, userID := p.sessionID, p.userID
+	r.mu.Unlock()
+
+	ctx, cancel := useCaseContext()
+	defer cancel()
+	output, err := r.hub.submitAnswerUC.Execute(ctx, gamesubmitanswer.SubmitAnswerInput{
+		QuestionID:       question.ID,
+		SelectedOptionID: data.SelectedOptionID,
+		AnswerText:       data.AnswerText,
+		ResponseTimeMs:   data.ResponseTimeMs,
+	}, sessionID, userID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.storeSnapshotLocked()
+
+	// The question may have closed while the answer was submitted; the answer is stored but wins nothing
+	open := r.status == roomPlaying && r.round == round
+	if open {
+		delete(r.pending, userID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	won := open && output.IsCorrect && !r.roundWon
+	c.sendMessage(MessageAnswerResult, AnswerResultData{
+		QuestionOrder:  question.QuestionOrder,
+		IsCorrect:      output.IsCorrect,
+		MatchResult:    output.MatchResult,
+		ExpectedAnswer: output.ExpectedAnswer,
+		XPEarned:       output.XPEarned,
+		WonPoint:       won,
+	})
+	if !open {
+		return false, nil
+	}
+
+	r.answered[userID] = true
+	if won {
+		r.roundWon = true
+		p.points++
+		r.broadcastLocked(MessagePoint, PointData{
+			QuestionOrder: question.QuestionOrder,
+			UserID:        userID,
+			Players:       r.playerStatesLocked(),
+		})
+		return r.advanceLocked(), nil
+	}
+	if r.allAnsweredLocked() {
+		return r.advanceLocked(), nil
+	}
+	return false, nil
+}
+
+// closeRound closes a question nobody answered correctly in time
+func (r *room) closeRound(round int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer r.storeSnapshotLocked()
+
+	if r.status != roomPlaying || r.round != round {
+		return false
+	}
+	return r.advanceLocked()
+}
+
+func (r *room) openRoundLocked(round int) {
+	r.round = round
+	r.roundWon = false
+	r.answered = make(map[int64]bool, len(r.players))
+	r.pending = make(map[int64]bool, len(r.players))
+
+	timeout := constants.DuelRoundTimeoutMs * time.Millisecond
+	r.closesAt = time.Now().Add(timeout)
+	for _, p := range r.players {
+		r.sendQuestionLocked(p)
+	}
+	r.timer = time.AfterFunc(timeout, func() {
+		if r.closeRound(round) {
+			r.hub.endRoom(r)
+		}
+	})
+}
+
+// advanceLocked opens the next question, or finishes the duel after the last one
+func (r *room) advanceLocked() bool {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	if r.round+1 < r.totalQuestions() {
+		r.openRoundLocked(r.round + 1)
+		return false
+	}
+	return r.finishLocked()
+}
+
+// finishLocked ends the room and settles the points of the players in the duel;
+// the caller then runs finish through hub.endRoom, outside the lock
+func (r *room) finishLocked() bool {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	r.status = roomEnded
+	r.storeSnapshotLocked()
+
+	if r.duel != nil {
+		for _, dp := range r.duel.Players {
+			if p := r.playerByUserID(dp.UserID); p != nil {
+				dp.Points = p.points
+			}
+		}
+	}
+	return true
+}
+
+// finish ends the sessions of the players of an ended room, stores the result and sends it to the players.
+// Nothing changes the duel of an ended room, so the use case runs without the lock.
+func (r *room) finish() {
+	r.mu.Lock()
+	duel := r.duel
+	r.mu.Unlock()
+	if duel == nil {
+		return
+	}
+
+	ctx, cancel := useCaseContext()
+	defer cancel()
+	if _, err := r.hub.finishDuelUC.Execute(ctx, gamefinishduel.FinishDuelInput{Duel: duel}); err != nil {
+		// The players still get the result of the duel they played
+		r.hub.logger.Error("failed to finish duel",
+			logger.Error(err),
+			logger.Int64("duel_id", duel.ID),
+		)
+		domain.RankDuelPlayers(duel.Players)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := DuelEndedData{
+		DuelID:  duel.ID,
+		Players: make([]DuelResultPlayer, 0, len(duel.Players)),
+	}
+	for _, dp := range duel.Players {
+		player := DuelResultPlayer{
+			UserID:    dp.UserID,
+			SessionID: dp.SessionID,
+			Points:    dp.Points,
+			Rank:      dp.Rank,
+		}
+		if p := r.playerByUserID(dp.UserID); p != nil {
+			player.Username = p.username
+		}
+		result.Players = append(result.Players, player)
+	}
+	r.broadcastLocked(MessageDuelEnded, result)
+}
+
+func (r *room) sendDuelStartedLocked(p *roomPlayer) {
+	p.client.sendMessage(MessageDuelStarted, DuelStartedData{
+		DuelID:         r.duel.ID,
+		SessionID:      p.sessionID,
+		TotalQuestions: r.totalQuestions(),
+		RoundTimeoutMs: constants.DuelRoundTimeoutMs,
+	})
+}
+
+func (r *room) sendQuestionLocked(p *roomPlayer) {
+	if !p.connected {
+		return
+	}
+	question := r.questionLocked(p.userID, r.round)
+	if question == nil {
+		return
+	}
+	p.client.sendMessage(MessageQuestion, QuestionData{
+		QuestionOrder: question.QuestionOrder,
+		QuestionID:    question.ID,
+		ClosesAt:      r.closesAt,
+	})
+}
+
+func (r *room) broadcastStateLocked() {
+	r.broadcastLocked(MessageRoomState, RoomStateData{
+		RoomCode:   r.code,
+		HostUserID: r.hostID,
+		Status:     r.status,
+		Settings:   r.settings,
+		Players:    r.playerStatesLocked(),
+	})
+}
+
+func (r *room) broadcastLocked(msgType string, data interface{}) {
+	for _, p := range r.players {
+		if p.connected {
+			p.client.sendMessage(msgType, data)
+		}
+	}
+}
+
+func (r *room) playerStatesLocked() []PlayerState {
+	states := make([]PlayerState, 0, len(r.players))
+	for _, p := range r.players {
+		states = append(states, PlayerState{
+			UserID:    p.userID,
+			Username:  p.username,
+			Points:    p.points,
+			Connected: p.connected,
+		})
+	}
+	return states
+}
+
+func (r *room) playerByUserID(userID int64) *roomPlayer {
+	for _, p := range r.players {
+		if p.userID == userID {
+			return p
+		}
+	}
+	return nil
+}
+
+// totalQuestions returns the number of rounds of the duel, the fewest questions of a player.
+// The duel is only started with as many questions for every player.
+func (r *room) totalQuestions() int {
+	if r.duel == nil || len(r.duel.Players) == 0 {
+		return 0
+	}
+	total := len(r.questions[r.duel.Players[0].UserID])
+	for _, dp := range r.duel.Players[1:] {
+		total = min(total, len(r.questions[dp.UserID]))
+	}
+	return total
+}
+
+// questionLocked returns the question of a player for a round, nil if the player has none
+func (r *room) questionLocked(userID int64, round int) *domain.GameQuestion {
+	questions := r.questions[userID]
+	if round < 0 || round >= len(questions) {
+		return nil
+	}
+	return questions[round]
+}
+
+func (r *room) connectedCount() int {
+	count := 0
+	for _, p := range r.players {
+		if p.connected {
+			count++
+		}
+	}
+	return count
+}
+
+// allAnsweredLocked reports whether every connected player answered the open question
+func (r *room) allAnsweredLocked() bool {
+	for _, p := range r.players {
+		if p.connected && !r.answered[p.userID] {
+			return false
+		}
+	}
+	return true
+}
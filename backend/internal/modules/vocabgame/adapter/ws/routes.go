@@ -0,0 +1,11 @@
+package ws
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes registers the duel WebSocket route
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler) {
+	// Duel route: /api/v1/vocabgames/duels/ws (protected - the handler authenticates the upgrade request itself)
+	router.GET("/vocabgames/duels/ws", handler.ServeDuel)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"unicode/utf8"
+
+	"github.com/english-coach/backend/internal/shared/textnorm"
+)
+
+// Match results of typed answers
+const (
+	// MatchResultCorrect means the answer matches an accepted answer once normalized
+	MatchResultCorrect = "correct"
+	// MatchResultAlmost means the answer is an accepted answer with a small typo; it counts as correct
+	MatchResultAlmost = "almost"
+	// MatchResultWrong means the answer matches no accepted answer
+	MatchResultWrong = "wrong"
+)
+
+// AcceptedAnswer is a word accepted as the answer of a typed question
+type AcceptedAnswer struct {
+	WordID int64
+	Lemma  string
+	Forms  []string // Other spellings of the word, such as lemma_normalized and search_key
+}
+
+// GradeTypedAnswer grades a typed answer against the accepted answers.
+// Answers are compared once normalized, so missing diacritics or tone marks and differences in
+// case, spacing or punctuation are tolerated. An answer within a small edit distance of an accepted
+// answer is "almost" correct. It returns the match result and the accepted answer that matched best,
+// or nil if the answer is wrong.
+func GradeTypedAnswer(answer string, accepted []AcceptedAnswer) (string, *AcceptedAnswer) {
+	normalized := textnorm.Normalize(answer)
+	if normalized == "" {
+		return MatchResultWrong, nil
+	}
+
+	var closest *AcceptedAnswer
+	closestDistance := -1
+	for i := range accepted {
+		candidate := &accepted[i]
+		for _, form := range append([]string{candidate.Lemma}, candidate.Forms...) {
+			expected := textnorm.Normalize(form)
+			if expected == "" {
+				continue
+			}
+			if expected == normalized {
+				return MatchResultCorrect, candidate
+			}
+
+			distance := textnorm.Distance(normalized, expected)
+			if distance <= typoTolerance(expected) && (closestDistance == -1 || distance < closestDistance) {
+				closest = candidate
+				closestDistance = distance
+			}
+		}
+	}
+
+	if closest != nil {
+		return MatchResultAlmost, closest
+	}
+	return MatchResultWrong, nil
+}
+
+// typoTolerance returns the number of edits tolerated for a normalized answer:
+// none for very short words, one for common words and two for long words
+func typoTolerance(expected string) int {
+	switch length := utf8.RuneCountInString(expected); {
+	case length <= 3:
+		return 0
+	case length <= 8:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import "testing"
+
+func TestGradeTypedAnswer(t *testing.T) {
+	accepted := []AcceptedAnswer{
+		{WordID: 1, Lemma: "apple"},
+		{WordID: 2, Lemma: "Xin chào"},
+		{WordID: 3, Lemma: "学习", Forms: []string{"xuexi", "xue2xi2"}},
+		{WordID: 4, Lemma: "cat"},
+		{WordID: 5, Lemma: "international"},
+		{WordID: 6, Lemma: "restaurant"},
+		{WordID: 7, Lemma: "restaurants"},
+	}
+
+	tests := []struct {
+		name       string
+		answer     string
+		wantResult string
+		wantWordID int64 // 0 means no accepted answer
+	}{
+		{"exact", "apple", MatchResultCorrect, 1},
+		{"case and spaces", "  APPLE ", MatchResultCorrect, 1},
+		{"missing diacritics", "xin chao", MatchResultCorrect, 2},
+		{"missing spaces", "xinchao", MatchResultCorrect, 2},
+		{"other form", "xuexi", MatchResultCorrect, 3},
+		{"form with tone numbers", "xue2xi2", MatchResultCorrect, 3},
+		{"lemma of a form", "学习", MatchResultCorrect, 3},
+		{"one typo in a common word", "aple", MatchResultAlmost, 1},
+		{"no typo tolerated in a short word", "cut", MatchResultWrong, 0},
+		{"one typo in a long word", "internatinal", MatchResultAlmost, 5},
+		{"two typos in a long word", "intrnatinal", MatchResultAlmost, 5},
+		{"three typos in a long word", "intrntinal", MatchResultWrong, 0},
+		{"closest accepted answer", "restaurnts", MatchResultAlmost, 7},
+		{"unrelated", "banana", MatchResultWrong, 0},
+		{"empty", "", MatchResultWrong, 0},
+		{"only punctuation", "?!", MatchResultWrong, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, matched := GradeTypedAnswer(tt.answer, accepted)
+			if result != tt.wantResult {
+				t.Errorf("GradeTypedAnswer(%q) result = %s, want %s", tt.answer, result, tt.wantResult)
+			}
+
+			var wordID int64
+			if matched != nil {
+				wordID = matched.WordID
+			}
+			if wordID != tt.wantWordID {
+				t.Errorf("GradeTypedAnswer(%q) matched word %d, want %d", tt.answer, wordID, tt.wantWordID)
+			}
+		})
+	}
+}
+
+func TestGradeTypedAnswerWithoutAcceptedAnswers(t *testing.T) {
+	if result, matched := GradeTypedAnswer("apple", nil); result != MatchResultWrong || matched != nil {
+		t.Errorf("GradeTypedAnswer without accepted answers = %s, %v, want %s, nil", result, matched, MatchResultWrong)
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// DailyChallengeEntry is the result of a user in the daily challenge of a day and language pair
+type DailyChallengeEntry struct {
+	Rank                int // Users with the same score and total response time share a rank
+	SessionID           int64
+	UserID              int64
+	DisplayName         string
+	CorrectQuestions    int16
+	TotalQuestions      int16
+	TotalResponseTimeMs int64 // From the start of the session to its last answer, measured by the server
+	EndedAt             time.Time
+}
+
+// DailyChallengeDay returns the day of the daily challenge being played at the given time.
+// Days are UTC days so that every user plays the same challenge at the same time.
+func DailyChallengeDay(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// DailyChallengeSeed returns the random seed of the daily challenge of a day and language pair,
+// so that the challenge is generated identically for every user
+func DailyChallengeSeed(day time.Time, sourceLanguageID, targetLanguageID int16) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s:%d:%d", day.Format("2006-01-02"), sourceLanguageID, targetLanguageID)
+	return int64(h.Sum64())
+}
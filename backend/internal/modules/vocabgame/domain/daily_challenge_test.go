@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailyChallengeDay(t *testing.T) {
+	hoChiMinh := time.FixedZone("ICT", 7*60*60)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{"start of the day", time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"end of the day", time.Date(2024, time.March, 10, 23, 59, 59, 0, time.UTC), time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+		{"UTC day of a local time", time.Date(2024, time.March, 11, 6, 0, 0, 0, hoChiMinh), time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DailyChallengeDay(tt.now)
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("DailyChallengeDay(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDailyChallengeSeed(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	seed := DailyChallengeSeed(day, 1, 2)
+
+	// The seed must not change between releases, or a challenge already played would be regenerated differently
+	if want := int64(7174600432236750996); seed != want {
+		t.Fatalf("DailyChallengeSeed(%v, 1, 2) = %d, want %d", day, seed, want)
+	}
+
+	tests := []struct {
+		name             string
+		day              time.Time
+		sourceLanguageID int16
+		targetLanguageID int16
+		wantSame         bool
+	}{
+		{"same day and pair", day, 1, 2, true},
+		{"later in the same day", DailyChallengeDay(day.Add(23 * time.Hour)), 1, 2, true},
+		{"next day", day.AddDate(0, 0, 1), 1, 2, false},
+		{"same day next year", day.AddDate(1, 0, 0), 1, 2, false},
+		{"other source language", day, 3, 2, false},
+		{"other target language", day, 1, 3, false},
+		{"reversed pair", day, 2, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DailyChallengeSeed(tt.day, tt.sourceLanguageID, tt.targetLanguageID)
+			if (got == seed) != tt.wantSame {
+				t.Errorf("DailyChallengeSeed(%v, %d, %d) = %d, seed of %v 1->2 is %d, want same: %v",
+					tt.day, tt.sourceLanguageID, tt.targetLanguageID, got, day, seed, tt.wantSame)
+			}
+		})
+	}
+}
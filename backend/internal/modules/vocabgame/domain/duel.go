@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Duel is a head-to-head game of players answering the same questions, each in a session of their own.
+// A point goes to the first player answering a question correctly.
+type Duel struct {
+	ID        int64
+	RoomCode  string
+	StartedAt time.Time
+	EndedAt   *time.Time
+	Players   []*DuelPlayer
+}
+
+// DuelPlayer is a player of a duel with the session they answer in
+type DuelPlayer struct {
+	UserID    int64
+	SessionID int64
+	Points    int16
+	Rank      int // Players with the same points share a rank, 0 until the duel ends
+}
+
+// RankDuelPlayers sorts the players by points and sets their ranks; players with the same points share a rank
+func RankDuelPlayers(players []*DuelPlayer) {
+	sort.SliceStable(players, func(i, j int) bool {
+		return players[i].Points > players[j].Points
+	})
+	for i, player := range players {
+		if i > 0 && player.Points == players[i-1].Points {
+			player.Rank = players[i-1].Rank
+			continue
+		}
+		player.Rank = i + 1
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "testing"
+
+func TestRankDuelPlayers(t *testing.T) {
+	type player struct {
+		userID int64
+		points int16
+	}
+	type ranked struct {
+		userID int64
+		rank   int
+	}
+
+	tests := []struct {
+		name    string
+		players []player
+		want    []ranked
+	}{
+		{"no players", nil, []ranked{}},
+		{"single player", []player{{1, 0}}, []ranked{{1, 1}}},
+		{"sorted by points", []player{{1, 2}, {2, 5}, {3, 3}}, []ranked{{2, 1}, {3, 2}, {1, 3}}},
+		{"tie shares a rank", []player{{1, 3}, {2, 1}, {3, 3}}, []ranked{{1, 1}, {3, 1}, {2, 3}}},
+		{"tie behind the winner", []player{{1, 1}, {2, 4}, {3, 1}}, []ranked{{2, 1}, {1, 2}, {3, 2}}},
+		{"everyone tied keeps the order", []player{{2, 0}, {1, 0}}, []ranked{{2, 1}, {1, 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := make([]*DuelPlayer, len(tt.players))
+			for i, p := range tt.players {
+				players[i] = &DuelPlayer{UserID: p.userID, Points: p.points}
+			}
+
+			RankDuelPlayers(players)
+
+			got := make([]ranked, len(players))
+			for i, p := range players {
+				got[i] = ranked{p.UserID, p.Rank}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("RankDuelPlayers ranked %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("RankDuelPlayers ranked %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -14,4 +14,15 @@ var (
 	ErrInvalidMode            = errors.New("Invalid mode")
 	ErrSessionNotOwned        = errors.New("Session is not owned by this user")
 	ErrTranslationNotFound    = errors.New("Translation not found")
+	ErrNoDueReviews           = errors.New("No words are due for review")
+	ErrAnswerTimeExpired      = errors.New("Answer time has expired")
+	ErrSessionNotEnded        = errors.New("Session has not ended yet")
+	ErrNoMistakesToRetry      = errors.New("Session has no wrong answers to retry")
+	ErrDailyChallengePlayed   = errors.New("Daily challenge has already been played today")
+	ErrDuelRoomNotFound       = errors.New("Duel room not found")
+	ErrDuelRoomFull           = errors.New("Duel room is full")
+	ErrDuelAlreadyStarted     = errors.New("Duel has already started")
+	ErrDuelNotHost            = errors.New("Only the host can start the duel")
+	ErrDuelNotEnoughPlayers   = errors.New("Not enough players to start the duel")
+	ErrProgressNotShared      = errors.New("User does not share their progress")
 )
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Progress event types, published on the progress topic of the user playing the session
+const (
+	// ProgressEventAnswerRecorded is published when an answer to a question of a session is stored
+	ProgressEventAnswerRecorded = "answer_recorded"
+	// ProgressEventSessionEnded is published when a session ends, completed by the user or by its last answer
+	ProgressEventSessionEnded = "session_ended"
+)
+
+// ProgressTopic returns the event topic of the progress of the sessions of a user
+func ProgressTopic(userID int64) string {
+	return fmt.Sprintf("vocabgame.progress.%d", userID)
+}
+
+// AnswerRecordedEvent is the payload of an answer_recorded event.
+// Events are encoded as JSON on the bus and streamed as they are to watchers.
+type AnswerRecordedEvent struct {
+	SessionID        int64     `json:"session_id"`
+	UserID           int64     `json:"user_id"`
+	QuestionID       int64     `json:"question_id"`
+	QuestionOrder    int16     `json:"question_order"`
+	IsCorrect        bool      `json:"is_correct"`
+	CorrectQuestions int16     `json:"correct_questions"` // Correct answers of the session so far
+	TotalQuestions   int16     `json:"total_questions"`
+	AnsweredAt       time.Time `json:"answered_at"`
+}
+
+// SessionEndedEvent is the payload of a session_ended event
+type SessionEndedEvent struct {
+	SessionID          int64     `json:"session_id"`
+	UserID             int64     `json:"user_id"`
+	Mode               string    `json:"mode"`
+	TotalQuestions     int16     `json:"total_questions"`
+	AnsweredQuestions  int       `json:"answered_questions"`
+	CorrectQuestions   int16     `json:"correct_questions"`
+	AccuracyPercentage float64   `json:"accuracy_percentage"`
+	EndedAt            time.Time `json:"ended_at"`
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // GameSessionRepository defines operations for vocabgame session data access
@@ -14,16 +15,21 @@ type GameSessionRepository interface {
 	FindGameSessionsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*GameSession, error)
 	// CountGameSessionsByUserID returns the total count of game sessions for a user
 	CountGameSessionsByUserID(ctx context.Context, userID int64) (int64, error)
-	// Update updates a vocabgame session
+	// Update updates the question count and end time of a session; the correct count is left untouched
 	Update(ctx context.Context, session *GameSession) error
-	// EndSession marks a session as ended
-	EndSession(ctx context.Context, sessionID int64, endedAt interface{}) error
+	// EndSession marks a session as ended and freezes its score; ended sessions are left untouched.
+	// It reports whether this call ended the session.
+	EndSession(ctx context.Context, sessionID int64, endedAt interface{}) (bool, error)
+	// FindDailyChallengeLeaderboard returns the ranked results of the ended daily challenges of a day and language pair
+	FindDailyChallengeLeaderboard(ctx context.Context, challengeDate time.Time, sourceLanguageID, targetLanguageID int16, limit, offset int) ([]*DailyChallengeEntry, error)
+	// CountDailyChallengeEntries returns the number of ended daily challenges of a day and language pair
+	CountDailyChallengeEntries(ctx context.Context, challengeDate time.Time, sourceLanguageID, targetLanguageID int16) (int64, error)
 }
 
 // GameQuestionRepository defines operations for vocabgame question data access
 type GameQuestionRepository interface {
-	// CreateBatch creates multiple questions and their options in a transaction
-	CreateBatch(ctx context.Context, questions []*GameQuestion, options []*GameQuestionOption) error
+	// CreateBatch creates multiple questions and the options attached to each of them in a transaction
+	CreateBatch(ctx context.Context, questions []*GameQuestion) error
 	// FindGameQuestionsBySessionID returns all questions for a session with their options
 	FindGameQuestionsBySessionID(ctx context.Context, sessionID int64) ([]*GameQuestion, error)
 	// FindGameQuestionByID returns a question by ID with its options
@@ -32,10 +38,30 @@ type GameQuestionRepository interface {
 
 // GameAnswerRepository defines operations for vocabgame answer data access
 type GameAnswerRepository interface {
-	// Create creates a new answer
-	Create(ctx context.Context, answer *GameAnswer) error
+	// Create creates a new answer and, if it is correct, increments the correct count of its session
+	// atomically. It returns the correct count of the session after the answer.
+	// A second answer to the same question returns ErrAnswerAlreadySubmitted.
+	Create(ctx context.Context, answer *GameAnswer) (int16, error)
 	// FindGameAnswerByQuestionID returns the answer for a specific question in a session
 	FindGameAnswerByQuestionID(ctx context.Context, questionID, sessionID, userID int64) (*GameAnswer, error)
 	// FindGameAnswersBySessionID returns all answers for a session
 	FindGameAnswersBySessionID(ctx context.Context, sessionID, userID int64) ([]*GameAnswer, error)
+	// CountGameAnswersBySessionID returns the number of answers submitted in a session
+	CountGameAnswersBySessionID(ctx context.Context, sessionID, userID int64) (int64, error)
+	// FindMissedWordIDsBySessionID returns the source words answered wrongly in a session, once each, in question order
+	FindMissedWordIDsBySessionID(ctx context.Context, sessionID, userID int64) ([]int64, error)
+}
+
+// DuelRepository defines operations for duel data access
+type DuelRepository interface {
+	// Create creates a duel and its players in a transaction
+	Create(ctx context.Context, duel *Duel) error
+	// Finish stores the end time of a duel and the points and rank of its players in a transaction
+	Finish(ctx context.Context, duel *Duel) error
+}
+
+// ProgressRepository defines operations for live progress data access
+type ProgressRepository interface {
+	// IsProgressShared reports whether a user lets other users watch their live progress
+	IsProgressShared(ctx context.Context, userID int64) (bool, error)
 }
@@ -11,6 +11,8 @@ type GameAnswer struct {
 	SelectedOptionID *int64    `json:"selected_option_id,omitempty"`
 	IsCorrect        bool      `json:"is_correct"`
 	ResponseTimeMs   *int      `json:"response_time_ms,omitempty"`
+	AnswerText       *string   `json:"answer_text,omitempty"`  // Typed questions only
+	MatchResult      *string   `json:"match_result,omitempty"` // Typed questions only: 'correct', 'almost' or 'wrong'
 	AnsweredAt       time.Time `json:"answered_at"`
 }
 
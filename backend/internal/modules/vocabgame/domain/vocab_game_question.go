@@ -2,6 +2,44 @@ package domain
 
 import "time"
 
+// Question types
+const (
+	// QuestionTypeWordToTranslation shows the source word and asks for its translation
+	QuestionTypeWordToTranslation = "word_to_translation"
+	// QuestionTypeTranslationToWord shows a translation and asks for the source word
+	QuestionTypeTranslationToWord = "translation_to_word"
+	// QuestionTypeDefinitionToWord shows a sense definition and asks for the source word
+	QuestionTypeDefinitionToWord = "definition_to_word"
+	// QuestionTypeExampleCloze shows an example sentence with the lemma blanked and asks for the source word
+	QuestionTypeExampleCloze = "example_cloze"
+	// QuestionTypePronunciationToWord shows the IPA transcription and asks for the source word
+	QuestionTypePronunciationToWord = "pronunciation_to_word"
+	// QuestionTypeTypedTranslation shows the source word and asks the learner to type its translation
+	QuestionTypeTypedTranslation = "typed_translation"
+)
+
+// IsValidQuestionType reports whether questionType is a supported question type
+func IsValidQuestionType(questionType string) bool {
+	switch questionType {
+	case QuestionTypeWordToTranslation, QuestionTypeTranslationToWord, QuestionTypeDefinitionToWord,
+		QuestionTypeExampleCloze, QuestionTypePronunciationToWord, QuestionTypeTypedTranslation:
+		return true
+	default:
+		return false
+	}
+}
+
+// AnswersWithSourceWord reports whether the answer of the question type is the source word itself
+// rather than one of its translations. Such questions must not reveal the source word.
+func AnswersWithSourceWord(questionType string) bool {
+	return questionType != QuestionTypeWordToTranslation && questionType != QuestionTypeTypedTranslation
+}
+
+// IsTypedAnswer reports whether questions of the type are answered by typing text instead of choosing an option
+func IsTypedAnswer(questionType string) bool {
+	return questionType == QuestionTypeTypedTranslation
+}
+
 // GameQuestion represents a single question within a vocabgame session
 type GameQuestion struct {
 	ID                  int64                 `json:"id"`
@@ -13,10 +51,16 @@ type GameQuestion struct {
 	CorrectTargetWordID int64                 `json:"correct_target_word_id"`
 	SourceLanguageID    int16                 `json:"source_language_id"`
 	TargetLanguageID    int16                 `json:"target_language_id"`
+	PromptText          *string               `json:"prompt_text,omitempty"` // nil means the source word lemma
 	CreatedAt           time.Time             `json:"created_at"`
 	Options             []*GameQuestionOption `json:"options"`
 }
 
+// IsCorrectOption reports whether choosing the option answers the question correctly
+func (q *GameQuestion) IsCorrectOption(option *GameQuestionOption) bool {
+	return option.QuestionID == q.ID && (option.IsCorrect || option.TargetWordID == q.CorrectTargetWordID)
+}
+
 // GameQuestionOption represents one of the four multiple-choice answers (A, B, C, D)
 type GameQuestionOption struct {
 	ID            int64  `json:"id"`
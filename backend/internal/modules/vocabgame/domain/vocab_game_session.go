@@ -2,18 +2,101 @@ package domain
 
 import "time"
 
+// Game session modes
+const (
+	// GameModeLevel builds questions from words of a level, optionally filtered by topics
+	GameModeLevel = "level"
+	// GameModeTopic builds questions from words of one or more topics, regardless of level
+	GameModeTopic = "topic"
+	// GameModeMixed builds questions from words of every level, optionally filtered by topics
+	GameModeMixed = "mixed"
+	// GameModeReview builds questions from words that are due for spaced-repetition review
+	GameModeReview = "review"
+	// GameModeDaily builds the daily challenge, the same questions for every user of a language pair
+	GameModeDaily = "daily"
+)
+
+// IsValidGameMode reports whether mode is a supported game session mode
+func IsValidGameMode(mode string) bool {
+	switch mode {
+	case GameModeLevel, GameModeTopic, GameModeMixed, GameModeReview, GameModeDaily:
+		return true
+	default:
+		return false
+	}
+}
+
 // GameSession represents a single vocabulary vocabgame playthrough
 type GameSession struct {
-	ID              int64     `json:"id"`
-	UserID          int64     `json:"user_id"`
-	Mode            string    `json:"mode"` // 'level' or 'topic'
-	SourceLanguageID int16    `json:"source_language_id"`
-	TargetLanguageID int16   `json:"target_language_id"`
-	TopicID         *int64   `json:"topic_id,omitempty"`
-	LevelID         *int64   `json:"level_id,omitempty"`
-	TotalQuestions  int16    `json:"total_questions"`
-	CorrectQuestions int16   `json:"correct_questions"`
-	StartedAt       time.Time `json:"started_at"`
-	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	ID                  int64      `json:"id"`
+	UserID              int64      `json:"user_id"`
+	Mode                string     `json:"mode"` // 'level', 'topic', 'mixed', 'review' or 'daily'
+	SourceLanguageID    int16      `json:"source_language_id"`
+	TargetLanguageID    int16      `json:"target_language_id"`
+	TopicID             *int64     `json:"topic_id,omitempty"`
+	LevelID             *int64     `json:"level_id,omitempty"`
+	TotalQuestions      int16      `json:"total_questions"`
+	CorrectQuestions    int16      `json:"correct_questions"`
+	StartedAt           time.Time  `json:"started_at"`
+	EndedAt             *time.Time `json:"ended_at,omitempty"`
+	OptionCount         int16      `json:"option_count"`                     // number of options of multiple-choice questions
+	QuestionTimeLimitMs *int       `json:"question_time_limit_ms,omitempty"` // nil means no time limit
+	ChallengeDate       *time.Time `json:"challenge_date,omitempty"`         // day of the daily challenge, nil for other sessions
+}
+
+// AnswerTimeGrace is added to the time limit of a question to absorb network latency
+const AnswerTimeGrace = 2 * time.Second
+
+// AnswerDeadline returns the time by which a question shown at questionStartedAt must be answered.
+// It reports false when the session has no time limit.
+func (s *GameSession) AnswerDeadline(questionStartedAt time.Time) (time.Time, bool) {
+	if s.QuestionTimeLimitMs == nil {
+		return time.Time{}, false
+	}
+	limit := time.Duration(*s.QuestionTimeLimitMs) * time.Millisecond
+	return questionStartedAt.Add(limit + AnswerTimeGrace), true
 }
 
+// QuestionShownAt returns when the next question of the session was shown: at the latest answer,
+// or when the session started for the first one
+func (s *GameSession) QuestionShownAt(answers []*GameAnswer) time.Time {
+	shownAt := s.StartedAt
+	for _, answer := range answers {
+		if answer.AnsweredAt.After(shownAt) {
+			shownAt = answer.AnsweredAt
+		}
+	}
+	return shownAt
+}
+
+// OpenQuestion returns the question open at now among the questions of the session, in question order,
+// with its deadline. A question is shown when the previous one is answered or its time is up, the first
+// one when the session starts. It returns nil when every question is answered or, in a timed session,
+// every unanswered question has expired. Sessions without a time limit have no deadline.
+func (s *GameSession) OpenQuestion(questions []*GameQuestion, answers []*GameAnswer, now time.Time) (*GameQuestion, *time.Time) {
+	answeredAt := make(map[int64]time.Time, len(answers))
+	for _, answer := range answers {
+		answeredAt[answer.QuestionID] = answer.AnsweredAt
+	}
+
+	questionStartedAt := s.StartedAt
+	for _, question := range questions {
+		if at, ok := answeredAt[question.ID]; ok {
+			if at.After(questionStartedAt) {
+				questionStartedAt = at
+			}
+			continue
+		}
+
+		deadline, ok := s.AnswerDeadline(questionStartedAt)
+		if !ok {
+			return question, nil
+		}
+		if !now.After(deadline) {
+			return question, &deadline
+		}
+		// The question expired unanswered, the next one was shown at its deadline
+		questionStartedAt = deadline
+	}
+	return nil, nil
+}
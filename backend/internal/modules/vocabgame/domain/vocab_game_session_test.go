@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGameSessionOpenQuestion(t *testing.T) {
+	startedAt := time.Date(2024, time.March, 10, 8, 0, 0, 0, time.UTC)
+	timeLimitMs := 10000
+	window := 10*time.Second + AnswerTimeGrace
+	questions := []*GameQuestion{{ID: 1, QuestionOrder: 1}, {ID: 2, QuestionOrder: 2}, {ID: 3, QuestionOrder: 3}}
+	answered := func(questionID int64, after time.Duration) *GameAnswer {
+		return &GameAnswer{QuestionID: questionID, AnsweredAt: startedAt.Add(after)}
+	}
+
+	tests := []struct {
+		name         string
+		timeLimitMs  *int
+		answers      []*GameAnswer
+		now          time.Duration // after the start of the session
+		wantQuestion int64         // 0 means no open question
+		wantDeadline time.Duration // after the start of the session, 0 means no deadline
+	}{
+		{"first question", &timeLimitMs, nil, 5 * time.Second, 1, window},
+		{"first question at its deadline", &timeLimitMs, nil, window, 1, window},
+		{"first question expired", &timeLimitMs, nil, window + time.Second, 2, 2 * window},
+		{"every question expired", &timeLimitMs, nil, 3*window + time.Second, 0, 0},
+		{"next question shown at the answer", &timeLimitMs, []*GameAnswer{answered(1, 3*time.Second)}, 10 * time.Second, 2, 3*time.Second + window},
+		{"question after an answer expired", &timeLimitMs, []*GameAnswer{answered(1, 3*time.Second)}, 3*time.Second + window + time.Second, 3, 3*time.Second + 2*window},
+		{"every question answered", &timeLimitMs, []*GameAnswer{answered(1, time.Second), answered(2, 2*time.Second), answered(3, 3*time.Second)}, 4 * time.Second, 0, 0},
+		{"no time limit", nil, nil, time.Hour, 1, 0},
+		{"no time limit after an answer", nil, []*GameAnswer{answered(1, time.Minute)}, time.Hour, 2, 0},
+		{"no time limit every question answered", nil, []*GameAnswer{answered(1, time.Second), answered(2, 2*time.Second), answered(3, 3*time.Second)}, time.Hour, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &GameSession{StartedAt: startedAt, QuestionTimeLimitMs: tt.timeLimitMs}
+			question, deadline := session.OpenQuestion(questions, tt.answers, startedAt.Add(tt.now))
+
+			var questionID int64
+			if question != nil {
+				questionID = question.ID
+			}
+			if questionID != tt.wantQuestion {
+				t.Errorf("OpenQuestion() question = %d, want %d", questionID, tt.wantQuestion)
+			}
+
+			switch {
+			case tt.wantDeadline == 0 && deadline != nil:
+				t.Errorf("OpenQuestion() deadline = %v, want none", *deadline)
+			case tt.wantDeadline != 0 && (deadline == nil || !deadline.Equal(startedAt.Add(tt.wantDeadline))):
+				t.Errorf("OpenQuestion() deadline = %v, want %v", deadline, startedAt.Add(tt.wantDeadline))
+			}
+		})
+	}
+}
@@ -16,8 +16,8 @@ type gameAnswerRepository struct {
 	*GameRepository
 }
 
-// Create creates a new answer
-func (r *gameAnswerRepository) Create(ctx context.Context, answer *domain.GameAnswer) error {
+// Create creates a new answer and increments the correct count of its session if it is correct
+func (r *gameAnswerRepository) Create(ctx context.Context, answer *domain.GameAnswer) (int16, error) {
 	var selectedOptionID pgtype.Int8
 	if answer.SelectedOptionID != nil {
 		selectedOptionID = pgtype.Int8{Int64: *answer.SelectedOptionID, Valid: true}
@@ -26,29 +26,38 @@ func (r *gameAnswerRepository) Create(ctx context.Context, answer *domain.GameAn
 	if answer.ResponseTimeMs != nil {
 		responseTimeMs = pgtype.Int4{Int32: int32(*answer.ResponseTimeMs), Valid: true}
 	}
+	var answerText, matchResult pgtype.Text
+	if answer.AnswerText != nil {
+		answerText = pgtype.Text{String: *answer.AnswerText, Valid: true}
+	}
+	if answer.MatchResult != nil {
+		matchResult = pgtype.Text{String: *answer.MatchResult, Valid: true}
+	}
 	answeredAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
 
-	result, err := r.queries.CreateGameAnswer(ctx, db.CreateGameAnswerParams{
+	result, err := r.queriesFor(ctx).CreateGameAnswer(ctx, db.CreateGameAnswerParams{
 		QuestionID:       answer.QuestionID,
 		SessionID:        answer.SessionID,
 		UserID:           answer.UserID,
 		SelectedOptionID: selectedOptionID,
 		IsCorrect:        answer.IsCorrect,
 		ResponseTimeMs:   responseTimeMs,
+		AnswerText:       answerText,
+		MatchResult:      matchResult,
 		AnsweredAt:       answeredAt,
 	})
 	if err != nil {
-		return sharederrors.MapVocabGameRepositoryError(err, "Create")
+		return 0, sharederrors.MapVocabGameRepositoryError(err, "Create")
 	}
 
 	answer.ID = result.ID
 	answer.AnsweredAt = result.AnsweredAt.Time
-	return nil
+	return result.CorrectQuestions, nil
 }
 
 // FindGameAnswerByQuestionID returns the answer for a specific question in a session
 func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, questionID, sessionID, userID int64) (*domain.GameAnswer, error) {
-	row, err := r.queries.FindGameAnswerByQuestionID(ctx, db.FindGameAnswerByQuestionIDParams{
+	row, err := r.queriesFor(ctx).FindGameAnswerByQuestionID(ctx, db.FindGameAnswerByQuestionIDParams{
 		QuestionID: questionID,
 		SessionID:  sessionID,
 		UserID:     userID,
@@ -59,6 +68,7 @@ func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, q
 
 	var selectedOptionID *int64
 	var responseTimeMs *int
+	var answerText, matchResult *string
 
 	if row.SelectedOptionID.Valid {
 		val := row.SelectedOptionID.Int64
@@ -68,6 +78,14 @@ func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, q
 		val := int(row.ResponseTimeMs.Int32)
 		responseTimeMs = &val
 	}
+	if row.AnswerText.Valid {
+		val := row.AnswerText.String
+		answerText = &val
+	}
+	if row.MatchResult.Valid {
+		val := row.MatchResult.String
+		matchResult = &val
+	}
 
 	return &domain.GameAnswer{
 		ID:               row.ID,
@@ -77,13 +95,15 @@ func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, q
 		SelectedOptionID: selectedOptionID,
 		IsCorrect:        row.IsCorrect,
 		ResponseTimeMs:   responseTimeMs,
+		AnswerText:       answerText,
+		MatchResult:      matchResult,
 		AnsweredAt:       row.AnsweredAt.Time,
 	}, nil
 }
 
 // FindGameAnswersBySessionID returns all answers for a session
 func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, sessionID, userID int64) ([]*domain.GameAnswer, error) {
-	rows, err := r.queries.FindGameAnswersBySessionID(ctx, db.FindGameAnswersBySessionIDParams{
+	rows, err := r.queriesFor(ctx).FindGameAnswersBySessionID(ctx, db.FindGameAnswersBySessionIDParams{
 		SessionID: sessionID,
 		UserID:    userID,
 	})
@@ -95,6 +115,7 @@ func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, s
 	for _, row := range rows {
 		var selectedOptionID *int64
 		var responseTimeMs *int
+		var answerText, matchResult *string
 
 		if row.SelectedOptionID.Valid {
 			val := row.SelectedOptionID.Int64
@@ -104,6 +125,14 @@ func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, s
 			val := int(row.ResponseTimeMs.Int32)
 			responseTimeMs = &val
 		}
+		if row.AnswerText.Valid {
+			val := row.AnswerText.String
+			answerText = &val
+		}
+		if row.MatchResult.Valid {
+			val := row.MatchResult.String
+			matchResult = &val
+		}
 
 		answers = append(answers, &domain.GameAnswer{
 			ID:               row.ID,
@@ -113,9 +142,35 @@ func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, s
 			SelectedOptionID: selectedOptionID,
 			IsCorrect:        row.IsCorrect,
 			ResponseTimeMs:   responseTimeMs,
+			AnswerText:       answerText,
+			MatchResult:      matchResult,
 			AnsweredAt:       row.AnsweredAt.Time,
 		})
 	}
 
 	return answers, nil
 }
+
+// CountGameAnswersBySessionID returns the number of answers submitted in a session
+func (r *gameAnswerRepository) CountGameAnswersBySessionID(ctx context.Context, sessionID, userID int64) (int64, error) {
+	count, err := r.queriesFor(ctx).CountGameAnswersBySessionID(ctx, db.CountGameAnswersBySessionIDParams{
+		SessionID: sessionID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return 0, sharederrors.MapVocabGameRepositoryError(err, "CountGameAnswersBySessionID")
+	}
+	return count, nil
+}
+
+// FindMissedWordIDsBySessionID returns the source words answered wrongly in a session, once each, in question order
+func (r *gameAnswerRepository) FindMissedWordIDsBySessionID(ctx context.Context, sessionID, userID int64) ([]int64, error) {
+	wordIDs, err := r.queriesFor(ctx).FindMissedWordIDsBySessionID(ctx, db.FindMissedWordIDsBySessionIDParams{
+		SessionID: sessionID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindMissedWordIDsBySessionID")
+	}
+	return wordIDs, nil
+}
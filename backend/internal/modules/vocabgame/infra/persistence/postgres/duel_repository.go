@@ -0,0 +1,77 @@
+package vocabgame
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/game"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// duelRepository implements DuelRepository using sqlc
+type duelRepository struct {
+	*GameRepository
+}
+
+// Create creates a duel and its players in a transaction
+func (r *duelRepository) Create(ctx context.Context, duel *domain.Duel) error {
+	err := platformdb.RunInTx(ctx, r.pool, func(ctx context.Context) error {
+		qtx := r.queriesFor(ctx)
+
+		duelID, err := qtx.CreateGameDuel(ctx, db.CreateGameDuelParams{
+			RoomCode:  duel.RoomCode,
+			StartedAt: pgtype.Timestamp{Time: duel.StartedAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		duel.ID = duelID
+
+		for _, player := range duel.Players {
+			if err := qtx.CreateGameDuelPlayer(ctx, db.CreateGameDuelPlayerParams{
+				DuelID:    duel.ID,
+				UserID:    player.UserID,
+				SessionID: player.SessionID,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return sharederrors.MapVocabGameRepositoryError(err, "CreateDuel")
+}
+
+// Finish stores the end time of a duel and the points and rank of its players in a transaction
+func (r *duelRepository) Finish(ctx context.Context, duel *domain.Duel) error {
+	var endedAt pgtype.Timestamp
+	if duel.EndedAt != nil {
+		endedAt = pgtype.Timestamp{Time: *duel.EndedAt, Valid: true}
+	}
+
+	err := platformdb.RunInTx(ctx, r.pool, func(ctx context.Context) error {
+		qtx := r.queriesFor(ctx)
+
+		if err := qtx.EndGameDuel(ctx, db.EndGameDuelParams{
+			ID:      duel.ID,
+			EndedAt: endedAt,
+		}); err != nil {
+			return err
+		}
+
+		for _, player := range duel.Players {
+			if err := qtx.UpdateGameDuelPlayerResult(ctx, db.UpdateGameDuelPlayerResultParams{
+				DuelID: duel.ID,
+				UserID: player.UserID,
+				Points: player.Points,
+				Rank:   pgtype.Int2{Int16: int16(player.Rank), Valid: player.Rank > 0},
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return sharederrors.MapVocabGameRepositoryError(err, "FinishDuel")
+}
@@ -1,9 +1,12 @@
 package vocabgame
 
 import (
+	"context"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
 	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/game"
 )
 
@@ -21,6 +24,14 @@ func NewGameRepository(pool *pgxpool.Pool) *GameRepository {
 	}
 }
 
+// queriesFor returns queries bound to the unit-of-work transaction of ctx, if any, or to the pool
+func (r *GameRepository) queriesFor(ctx context.Context) *db.Queries {
+	if tx, ok := platformdb.TxFromContext(ctx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // GameSessionRepository returns a GameSessionRepository implementation
 func (r *GameRepository) GameSessionRepository() domain.GameSessionRepository {
 	return &gameSessionRepository{
@@ -41,3 +52,17 @@ func (r *GameRepository) GameAnswerRepository() domain.GameAnswerRepository {
 		GameRepository: r,
 	}
 }
+
+// DuelRepository returns a DuelRepository implementation
+func (r *GameRepository) DuelRepository() domain.DuelRepository {
+	return &duelRepository{
+		GameRepository: r,
+	}
+}
+
+// ProgressRepository returns a ProgressRepository implementation
+func (r *GameRepository) ProgressRepository() domain.ProgressRepository {
+	return &progressRepository{
+		GameRepository: r,
+	}
+}
@@ -0,0 +1,24 @@
+package vocabgame
+
+import (
+	"context"
+
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// progressRepository implements ProgressRepository using sqlc
+type progressRepository struct {
+	*GameRepository
+}
+
+// IsProgressShared reports whether a user lets other users watch their live progress
+func (r *progressRepository) IsProgressShared(ctx context.Context, userID int64) (bool, error) {
+	shared, err := r.queries.FindUserShareProgress(ctx, userID)
+	if err != nil {
+		if sharederrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, sharederrors.MapVocabGameRepositoryError(err, "IsProgressShared")
+	}
+	return shared, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	platformdb "github.com/english-coach/backend/internal/platform/db"
 	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/game"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 )
@@ -16,89 +17,71 @@ type gameQuestionRepository struct {
 	*GameRepository
 }
 
-// CreateBatch creates multiple questions and their options in a transaction
-func (r *gameQuestionRepository) CreateBatch(ctx context.Context, questions []*domain.GameQuestion, options []*domain.GameQuestionOption) error {
-	tx, err := r.pool.Begin(ctx)
-	if err != nil {
-		return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
-	}
-	defer tx.Rollback(ctx)
-
-	qtx := r.queries.WithTx(tx)
-
-	// Insert questions
-	for _, question := range questions {
-		var sourceSenseID pgtype.Int8
-		if question.SourceSenseID != nil {
-			sourceSenseID = pgtype.Int8{Int64: *question.SourceSenseID, Valid: true}
-		}
-		createdAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
-
-		result, err := qtx.CreateGameQuestion(ctx, db.CreateGameQuestionParams{
-			SessionID:           question.SessionID,
-			QuestionOrder:       question.QuestionOrder,
-			QuestionType:        question.QuestionType,
-			SourceWordID:        question.SourceWordID,
-			SourceSenseID:       sourceSenseID,
-			CorrectTargetWordID: question.CorrectTargetWordID,
-			SourceLanguageID:    question.SourceLanguageID,
-			TargetLanguageID:    question.TargetLanguageID,
-			CreatedAt:           createdAt,
-		})
-		if err != nil {
-			return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
-		}
-		question.ID = result.ID
-		question.CreatedAt = result.CreatedAt.Time
-	}
-
-	// Insert options
-	// Each question has exactly 4 options (A, B, C, D), so we map options to questions by index
-	const optionsPerQuestion = 4
-	expectedOptionsCount := len(questions) * optionsPerQuestion
-	if len(options) != expectedOptionsCount {
-		return sharederrors.NewAppError(
-			sharederrors.CodeInternalError,
-			"mismatch between questions and options count",
-		).WithMetadata("expected", expectedOptionsCount).
-			WithMetadata("questions", len(questions)).
-			WithMetadata("options", len(options))
-	}
-
-	for i, question := range questions {
-		// Calculate the start index for this question's options
-		optionStartIndex := i * optionsPerQuestion
-		optionEndIndex := optionStartIndex + optionsPerQuestion
-
-		// Insert options for this question
-		for j := optionStartIndex; j < optionEndIndex; j++ {
-			option := options[j]
-			// Update the option's QuestionID to the actual question ID
-			option.QuestionID = question.ID
-
-			optionID, err := qtx.CreateGameQuestionOption(ctx, db.CreateGameQuestionOptionParams{
-				QuestionID:   option.QuestionID,
-				OptionLabel:  option.OptionLabel,
-				TargetWordID: option.TargetWordID,
-				IsCorrect:    option.IsCorrect,
+// CreateBatch creates multiple questions and the options attached to each of them in a transaction
+func (r *gameQuestionRepository) CreateBatch(ctx context.Context, questions []*domain.GameQuestion) error {
+	// Join the unit-of-work transaction of ctx, or run in a transaction of our own
+	err := platformdb.RunInTx(ctx, r.pool, func(ctx context.Context) error {
+		qtx := r.queriesFor(ctx)
+
+		// Insert questions
+		for _, question := range questions {
+			var sourceSenseID pgtype.Int8
+			if question.SourceSenseID != nil {
+				sourceSenseID = pgtype.Int8{Int64: *question.SourceSenseID, Valid: true}
+			}
+			var promptText pgtype.Text
+			if question.PromptText != nil {
+				promptText = pgtype.Text{String: *question.PromptText, Valid: true}
+			}
+			createdAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+			result, err := qtx.CreateGameQuestion(ctx, db.CreateGameQuestionParams{
+				SessionID:           question.SessionID,
+				QuestionOrder:       question.QuestionOrder,
+				QuestionType:        question.QuestionType,
+				SourceWordID:        question.SourceWordID,
+				SourceSenseID:       sourceSenseID,
+				CorrectTargetWordID: question.CorrectTargetWordID,
+				SourceLanguageID:    question.SourceLanguageID,
+				TargetLanguageID:    question.TargetLanguageID,
+				PromptText:          promptText,
+				CreatedAt:           createdAt,
 			})
 			if err != nil {
 				return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
 			}
-			option.ID = optionID
+			question.ID = result.ID
+			question.CreatedAt = result.CreatedAt.Time
 		}
-	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
-	}
-	return nil
+		// Insert options
+		// Typed questions have no options, multiple-choice questions carry theirs in question.Options
+		for _, question := range questions {
+			for _, option := range question.Options {
+				// Update the option's QuestionID to the actual question ID
+				option.QuestionID = question.ID
+
+				optionID, err := qtx.CreateGameQuestionOption(ctx, db.CreateGameQuestionOptionParams{
+					QuestionID:   option.QuestionID,
+					OptionLabel:  option.OptionLabel,
+					TargetWordID: option.TargetWordID,
+					IsCorrect:    option.IsCorrect,
+				})
+				if err != nil {
+					return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
+				}
+				option.ID = optionID
+			}
+		}
+
+		return nil
+	})
+	return sharederrors.MapVocabGameRepositoryError(err, "CreateBatch")
 }
 
 // FindGameQuestionsBySessionID returns all questions for a session with their options
 func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Context, sessionID int64) ([]*domain.GameQuestion, error) {
-	questionRows, err := r.queries.FindGameQuestionsBySessionID(ctx, sessionID)
+	questionRows, err := r.queriesFor(ctx).FindGameQuestionsBySessionID(ctx, sessionID)
 	if err != nil {
 		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameQuestionsBySessionID")
 	}
@@ -111,6 +94,11 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 			val := row.SourceSenseID.Int64
 			sourceSenseID = &val
 		}
+		var promptText *string
+		if row.PromptText.Valid {
+			val := row.PromptText.String
+			promptText = &val
+		}
 
 		question := &domain.GameQuestion{
 			ID:                  row.ID,
@@ -122,6 +110,7 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 			CorrectTargetWordID: row.CorrectTargetWordID,
 			SourceLanguageID:    row.SourceLanguageID,
 			TargetLanguageID:    row.TargetLanguageID,
+			PromptText:          promptText,
 			CreatedAt:           row.CreatedAt.Time,
 			Options:             []*domain.GameQuestionOption{},
 		}
@@ -133,7 +122,7 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 		return questions, nil
 	}
 
-	optionRows, err := r.queries.FindGameQuestionOptionsByQuestionIDs(ctx, questionIDs)
+	optionRows, err := r.queriesFor(ctx).FindGameQuestionOptionsByQuestionIDs(ctx, questionIDs)
 	if err != nil {
 		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameQuestionsBySessionID")
 	}
@@ -163,7 +152,7 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 
 // FindGameQuestionByID returns a question by ID with its options
 func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, questionID int64) (*domain.GameQuestion, error) {
-	questionRow, err := r.queries.FindGameQuestionByID(ctx, questionID)
+	questionRow, err := r.queriesFor(ctx).FindGameQuestionByID(ctx, questionID)
 	if err != nil {
 		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameQuestionByID")
 	}
@@ -173,6 +162,11 @@ func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, quest
 		val := questionRow.SourceSenseID.Int64
 		sourceSenseID = &val
 	}
+	var promptText *string
+	if questionRow.PromptText.Valid {
+		val := questionRow.PromptText.String
+		promptText = &val
+	}
 
 	question := &domain.GameQuestion{
 		ID:                  questionRow.ID,
@@ -184,11 +178,12 @@ func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, quest
 		CorrectTargetWordID: questionRow.CorrectTargetWordID,
 		SourceLanguageID:    questionRow.SourceLanguageID,
 		TargetLanguageID:    questionRow.TargetLanguageID,
+		PromptText:          promptText,
 		CreatedAt:           questionRow.CreatedAt.Time,
 		Options:             []*domain.GameQuestionOption{},
 	}
 
-	optionRows, err := r.queries.FindGameQuestionOptionsByQuestionID(ctx, questionID)
+	optionRows, err := r.queriesFor(ctx).FindGameQuestionOptionsByQuestionID(ctx, questionID)
 	if err != nil {
 		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameQuestionByID")
 	}
@@ -29,17 +29,29 @@ func (r *gameSessionRepository) Create(ctx context.Context, session *domain.Game
 	totalQuestions := pgtype.Int2{Int16: session.TotalQuestions, Valid: true}
 	correctQuestions := pgtype.Int2{Int16: session.CorrectQuestions, Valid: true}
 	startedAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
+	optionCount := pgtype.Int2{Int16: session.OptionCount, Valid: session.OptionCount > 0}
+	var questionTimeLimitMs pgtype.Int4
+	if session.QuestionTimeLimitMs != nil {
+		questionTimeLimitMs = pgtype.Int4{Int32: int32(*session.QuestionTimeLimitMs), Valid: true}
+	}
+	var challengeDate pgtype.Date
+	if session.ChallengeDate != nil {
+		challengeDate = pgtype.Date{Time: *session.ChallengeDate, Valid: true}
+	}
 
-	result, err := r.queries.CreateGameSession(ctx, db.CreateGameSessionParams{
-		UserID:           session.UserID,
-		Mode:             session.Mode,
-		SourceLanguageID: session.SourceLanguageID,
-		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          topicID,
-		LevelID:          levelID,
-		TotalQuestions:   totalQuestions,
-		CorrectQuestions: correctQuestions,
-		StartedAt:        startedAt,
+	result, err := r.queriesFor(ctx).CreateGameSession(ctx, db.CreateGameSessionParams{
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             topicID,
+		LevelID:             levelID,
+		TotalQuestions:      totalQuestions,
+		CorrectQuestions:    correctQuestions,
+		StartedAt:           startedAt,
+		OptionCount:         optionCount,
+		QuestionTimeLimitMs: questionTimeLimitMs,
+		ChallengeDate:       challengeDate,
 	})
 	if err != nil {
 		return sharederrors.MapVocabGameRepositoryError(err, "Create")
@@ -52,62 +64,34 @@ func (r *gameSessionRepository) Create(ctx context.Context, session *domain.Game
 
 // FindGameSessionByID returns a vocabgame session by ID
 func (r *gameSessionRepository) FindGameSessionByID(ctx context.Context, id int64) (*domain.GameSession, error) {
-	row, err := r.queries.FindGameSessionByID(ctx, id)
+	row, err := r.queriesFor(ctx).FindGameSessionByID(ctx, id)
 	if err != nil {
-		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindSessionByID")
-	}
-
-	var topicID, levelID *int64
-	var endedAt *time.Time
-
-	if row.TopicID.Valid {
-		val := row.TopicID.Int64
-		topicID = &val
-	}
-	if row.LevelID.Valid {
-		val := row.LevelID.Int64
-		levelID = &val
-	}
-	if row.EndedAt.Valid {
-		endedAt = &row.EndedAt.Time
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameSessionByID")
 	}
 
-	return &domain.GameSession{
-		ID:               row.ID,
-		UserID:           row.UserID,
-		Mode:             row.Mode,
-		SourceLanguageID: row.SourceLanguageID,
-		TargetLanguageID: row.TargetLanguageID,
-		TopicID:          topicID,
-		LevelID:          levelID,
-		TotalQuestions:   int16(row.TotalQuestions.Int16),
-		CorrectQuestions: int16(row.CorrectQuestions.Int16),
-		StartedAt:        row.StartedAt.Time,
-		EndedAt:          endedAt,
-	}, nil
+	return mapGameSessionRow(row), nil
 }
 
-// Update updates a vocabgame session
+// Update updates the question count and end time of a vocabgame session.
+// The correct count is maintained by the answer repository and is not written here.
 func (r *gameSessionRepository) Update(ctx context.Context, session *domain.GameSession) error {
 	totalQuestions := pgtype.Int2{Int16: session.TotalQuestions, Valid: true}
-	correctQuestions := pgtype.Int2{Int16: session.CorrectQuestions, Valid: true}
 	var endedAt pgtype.Timestamp
 	if session.EndedAt != nil {
 		endedAt = pgtype.Timestamp{Time: *session.EndedAt, Valid: true}
 	}
 
-	err := r.queries.UpdateGameSession(ctx, db.UpdateGameSessionParams{
-		ID:               session.ID,
-		TotalQuestions:   totalQuestions,
-		CorrectQuestions: correctQuestions,
-		EndedAt:          endedAt,
+	err := r.queriesFor(ctx).UpdateGameSession(ctx, db.UpdateGameSessionParams{
+		ID:             session.ID,
+		TotalQuestions: totalQuestions,
+		EndedAt:        endedAt,
 	})
 	return sharederrors.MapVocabGameRepositoryError(err, "Update")
 }
 
 // FindGameSessionsByUserID returns a list of game sessions for a user with pagination
 func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, userID int64, limit, offset int) ([]*domain.GameSession, error) {
-	rows, err := r.queries.FindGameSessionsByUserID(ctx, db.FindGameSessionsByUserIDParams{
+	rows, err := r.queriesFor(ctx).FindGameSessionsByUserID(ctx, db.FindGameSessionsByUserIDParams{
 		UserID: userID,
 		Offset: int32(offset),
 		Limit:  int32(limit),
@@ -118,34 +102,7 @@ func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, us
 
 	sessions := make([]*domain.GameSession, 0, len(rows))
 	for _, row := range rows {
-		var topicID, levelID *int64
-		var endedAt *time.Time
-
-		if row.TopicID.Valid {
-			val := row.TopicID.Int64
-			topicID = &val
-		}
-		if row.LevelID.Valid {
-			val := row.LevelID.Int64
-			levelID = &val
-		}
-		if row.EndedAt.Valid {
-			endedAt = &row.EndedAt.Time
-		}
-
-		sessions = append(sessions, &domain.GameSession{
-			ID:               row.ID,
-			UserID:           row.UserID,
-			Mode:             row.Mode,
-			SourceLanguageID: row.SourceLanguageID,
-			TargetLanguageID: row.TargetLanguageID,
-			TopicID:          topicID,
-			LevelID:          levelID,
-			TotalQuestions:   int16(row.TotalQuestions.Int16),
-			CorrectQuestions: int16(row.CorrectQuestions.Int16),
-			StartedAt:        row.StartedAt.Time,
-			EndedAt:          endedAt,
-		})
+		sessions = append(sessions, mapGameSessionRow(row))
 	}
 
 	return sessions, nil
@@ -153,15 +110,16 @@ func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, us
 
 // CountGameSessionsByUserID returns the total count of game sessions for a user
 func (r *gameSessionRepository) CountGameSessionsByUserID(ctx context.Context, userID int64) (int64, error) {
-	count, err := r.queries.CountGameSessionsByUserID(ctx, userID)
+	count, err := r.queriesFor(ctx).CountGameSessionsByUserID(ctx, userID)
 	if err != nil {
 		return 0, sharederrors.MapVocabGameRepositoryError(err, "CountGameSessionsByUserID")
 	}
 	return count, nil
 }
 
-// EndSession marks a session as ended
-func (r *gameSessionRepository) EndSession(ctx context.Context, sessionID int64, endedAt interface{}) error {
+// EndSession marks a session as ended and freezes its score; ended sessions are left untouched.
+// It reports whether this call ended the session.
+func (r *gameSessionRepository) EndSession(ctx context.Context, sessionID int64, endedAt interface{}) (bool, error) {
 	var endTime time.Time
 	if endedAt != nil {
 		if t, ok := endedAt.(time.Time); ok {
@@ -174,9 +132,99 @@ func (r *gameSessionRepository) EndSession(ctx context.Context, sessionID int64,
 	}
 
 	endedAtPg := pgtype.Timestamp{Time: endTime, Valid: true}
-	err := r.queries.EndGameSession(ctx, db.EndGameSessionParams{
+	rowsAffected, err := r.queriesFor(ctx).EndGameSession(ctx, db.EndGameSessionParams{
 		ID:      sessionID,
 		EndedAt: endedAtPg,
 	})
-	return sharederrors.MapVocabGameRepositoryError(err, "EndSession")
+	if err != nil {
+		return false, sharederrors.MapVocabGameRepositoryError(err, "EndSession")
+	}
+	return rowsAffected > 0, nil
+}
+
+// FindDailyChallengeLeaderboard returns the ranked results of the ended daily challenges of a day and language pair
+func (r *gameSessionRepository) FindDailyChallengeLeaderboard(ctx context.Context, challengeDate time.Time, sourceLanguageID, targetLanguageID int16, limit, offset int) ([]*domain.DailyChallengeEntry, error) {
+	rows, err := r.queriesFor(ctx).FindDailyChallengeLeaderboard(ctx, db.FindDailyChallengeLeaderboardParams{
+		ChallengeDate:    pgtype.Date{Time: challengeDate, Valid: true},
+		SourceLanguageID: sourceLanguageID,
+		TargetLanguageID: targetLanguageID,
+		Offset:           int32(offset),
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindDailyChallengeLeaderboard")
+	}
+
+	entries := make([]*domain.DailyChallengeEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, &domain.DailyChallengeEntry{
+			Rank:                int(row.Rank),
+			SessionID:           row.SessionID,
+			UserID:              row.UserID,
+			DisplayName:         row.DisplayName,
+			CorrectQuestions:    row.CorrectQuestions,
+			TotalQuestions:      row.TotalQuestions,
+			TotalResponseTimeMs: row.TotalResponseTimeMs,
+			EndedAt:             row.EndedAt.Time,
+		})
+	}
+
+	return entries, nil
+}
+
+// CountDailyChallengeEntries returns the number of ended daily challenges of a day and language pair
+func (r *gameSessionRepository) CountDailyChallengeEntries(ctx context.Context, challengeDate time.Time, sourceLanguageID, targetLanguageID int16) (int64, error) {
+	count, err := r.queriesFor(ctx).CountDailyChallengeEntries(ctx, db.CountDailyChallengeEntriesParams{
+		ChallengeDate:    pgtype.Date{Time: challengeDate, Valid: true},
+		SourceLanguageID: sourceLanguageID,
+		TargetLanguageID: targetLanguageID,
+	})
+	if err != nil {
+		return 0, sharederrors.MapVocabGameRepositoryError(err, "CountDailyChallengeEntries")
+	}
+	return count, nil
+}
+
+// mapGameSessionRow maps a vocab_game_sessions row to a domain session
+func mapGameSessionRow(row db.VocabGameSession) *domain.GameSession {
+	var topicID, levelID *int64
+	var endedAt *time.Time
+	var questionTimeLimitMs *int
+	var challengeDate *time.Time
+
+	if row.TopicID.Valid {
+		val := row.TopicID.Int64
+		topicID = &val
+	}
+	if row.LevelID.Valid {
+		val := row.LevelID.Int64
+		levelID = &val
+	}
+	if row.EndedAt.Valid {
+		endedAt = &row.EndedAt.Time
+	}
+	if row.QuestionTimeLimitMs.Valid {
+		val := int(row.QuestionTimeLimitMs.Int32)
+		questionTimeLimitMs = &val
+	}
+	if row.ChallengeDate.Valid {
+		challengeDate = &row.ChallengeDate.Time
+	}
+
+	return &domain.GameSession{
+		ID:                  row.ID,
+		UserID:              row.UserID,
+		Mode:                row.Mode,
+		SourceLanguageID:    row.SourceLanguageID,
+		TargetLanguageID:    row.TargetLanguageID,
+		TopicID:             topicID,
+		LevelID:             levelID,
+		TotalQuestions:      int16(row.TotalQuestions.Int16),
+		CorrectQuestions:    int16(row.CorrectQuestions.Int16),
+		StartedAt:           row.StartedAt.Time,
+		EndedAt:             endedAt,
+		OptionCount:         row.OptionCount.Int16,
+		QuestionTimeLimitMs: questionTimeLimitMs,
+		ChallengeDate:       challengeDate,
+	}
 }
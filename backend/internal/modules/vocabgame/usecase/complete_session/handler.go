@@ -0,0 +1,233 @@
+package complete_session
+
+import (
+	"context"
+	"math"
+	"time"
+
+	statsdomain "github.com/english-coach/backend/internal/modules/statistics/domain"
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/eventbus"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/uow"
+)
+
+// Handler handles vocabgame session completion
+type Handler struct {
+	sessionRepo    domain.GameSessionRepository
+	answerRepo     domain.GameAnswerRepository
+	statisticsRepo statsdomain.UserStatisticsRepository
+	awardUC        *statsawardachievements.Handler
+	eventBus       eventbus.Bus
+	uow            uow.UnitOfWork
+	logger         logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo domain.GameSessionRepository,
+	answerRepo domain.GameAnswerRepository,
+	statisticsRepo statsdomain.UserStatisticsRepository,
+	awardUC *statsawardachievements.Handler,
+	eventBus eventbus.Bus,
+	uow uow.UnitOfWork,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:    sessionRepo,
+		answerRepo:     answerRepo,
+		statisticsRepo: statisticsRepo,
+		awardUC:        awardUC,
+		eventBus:       eventBus,
+		uow:            uow,
+		logger:         logger,
+	}
+}
+
+// Execute sets ended_at on the session, freezes its score and returns a summary.
+// Completing an already ended session is idempotent and returns the frozen summary.
+func (h *Handler) Execute(ctx context.Context, input CompleteSessionInput) (*CompleteSessionOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotFound)
+	}
+
+	// Verify user owns session
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
+	}
+
+	// The session ends together with the statistics of its completion, or not at all
+	var summary *CompleteSessionOutput
+	var endedNow bool
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		summary, endedNow, err = h.EndSession(ctx, session)
+		if err != nil || !endedNow {
+			return err
+		}
+
+		activity := statsdomain.Activity{
+			UserID:           summary.UserID,
+			CompletedSession: SessionActivity(summary),
+		}
+		if err := h.statisticsRepo.RecordActivity(ctx, activity); err != nil {
+			h.logger.Error("failed to record session statistics",
+				logger.Error(err),
+				logger.Int64("session_id", summary.ID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	if endedNow {
+		h.PublishSessionEnded(ctx, summary)
+
+		// Completing a session may earn level session achievements
+		awarded, err := h.awardUC.Execute(ctx, statsawardachievements.AwardAchievementsInput{UserID: summary.UserID})
+		if err != nil {
+			// The session is already ended, achievements are awarded again on the next activity
+			h.logger.Error("failed to award achievements",
+				logger.Error(err),
+				logger.Int64("session_id", summary.ID),
+			)
+		} else {
+			summary.NewAchievements = awarded.Earned
+		}
+	}
+
+	return summary, nil
+}
+
+// EndSession ends the given session and builds its summary without recording statistics.
+// It reports whether this call ended the session, so callers can record the completion
+// together with their own aggregates in one unit of work, and publish it with PublishSessionEnded once committed.
+func (h *Handler) EndSession(ctx context.Context, session *domain.GameSession) (*CompleteSessionOutput, bool, error) {
+	endedNow := false
+	if session.EndedAt == nil {
+		ended, err := h.sessionRepo.EndSession(ctx, session.ID, time.Now())
+		if err != nil {
+			h.logger.Error("failed to end session",
+				logger.Error(err),
+				logger.Int64("session_id", session.ID),
+			)
+			return nil, false, sharederrors.MapDomainErrorToAppError(err)
+		}
+		endedNow = ended
+
+		// Reload to pick up the frozen score and ended_at
+		sessionID := session.ID
+		session, err = h.sessionRepo.FindGameSessionByID(ctx, sessionID)
+		if err != nil {
+			h.logger.Error("failed to reload completed session",
+				logger.Error(err),
+				logger.Int64("session_id", sessionID),
+			)
+			return nil, false, sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		if endedNow {
+			h.logger.Info("vocabgame session completed",
+				logger.Int64("session_id", session.ID),
+				logger.Int64("user_id", session.UserID),
+				logger.Int("correct_questions", int(session.CorrectQuestions)),
+				logger.Int("total_questions", int(session.TotalQuestions)),
+			)
+		}
+	}
+
+	answeredCount, err := h.answerRepo.CountGameAnswersBySessionID(ctx, session.ID, session.UserID)
+	if err != nil {
+		h.logger.Error("failed to count session answers",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, false, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	return buildSummary(session, int(answeredCount)), endedNow, nil
+}
+
+// PublishSessionEnded notifies the watchers of the user's progress that the session ended.
+// The session is already ended, so failures are logged only.
+func (h *Handler) PublishSessionEnded(ctx context.Context, summary *CompleteSessionOutput) {
+	event, err := eventbus.NewEvent(domain.ProgressTopic(summary.UserID), domain.ProgressEventSessionEnded, domain.SessionEndedEvent{
+		SessionID:          summary.ID,
+		UserID:             summary.UserID,
+		Mode:               summary.Mode,
+		TotalQuestions:     summary.TotalQuestions,
+		AnsweredQuestions:  summary.AnsweredQuestions,
+		CorrectQuestions:   summary.CorrectQuestions,
+		AccuracyPercentage: summary.AccuracyPercentage,
+		EndedAt:            summary.EndedAt,
+	})
+	if err == nil {
+		err = h.eventBus.Publish(ctx, event)
+	}
+	if err != nil {
+		h.logger.Error("failed to publish session ended event",
+			logger.Error(err),
+			logger.Int64("session_id", summary.ID),
+		)
+	}
+}
+
+// SessionActivity maps a completion summary to the statistics activity of the session
+func SessionActivity(summary *CompleteSessionOutput) *statsdomain.SessionActivity {
+	return &statsdomain.SessionActivity{
+		SessionID:       summary.ID,
+		DurationSeconds: summary.DurationSeconds,
+		EndedAt:         summary.EndedAt,
+	}
+}
+
+// buildSummary maps an ended session and its answer count to the completion summary
+func buildSummary(session *domain.GameSession, answered int) *CompleteSessionOutput {
+	correct := int(session.CorrectQuestions)
+
+	output := &CompleteSessionOutput{
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             session.TopicID,
+		LevelID:             session.LevelID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		AnsweredQuestions:   answered,
+		WrongAnswers:        answered - correct,
+		StartedAt:           session.StartedAt,
+		EndedAt:             time.Now(),
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+	}
+	if session.EndedAt != nil {
+		output.EndedAt = *session.EndedAt
+	}
+
+	if unanswered := int(session.TotalQuestions) - answered; unanswered > 0 {
+		output.UnansweredQuestions = unanswered
+	}
+	if answered > 0 {
+		output.AccuracyPercentage = math.Round(float64(correct)*100/float64(answered)*100) / 100
+	}
+	if duration := output.EndedAt.Sub(output.StartedAt); duration > 0 {
+		output.DurationSeconds = int(duration / time.Second)
+	}
+
+	return output
+}
@@ -0,0 +1,7 @@
+package complete_session
+
+// CompleteSessionInput represents the input to complete a vocabgame session use case.
+type CompleteSessionInput struct {
+	SessionID int64
+	UserID    int64
+}
@@ -0,0 +1,30 @@
+package complete_session
+
+import (
+	"time"
+
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
+)
+
+// CompleteSessionOutput represents the summary of a completed vocabgame session.
+type CompleteSessionOutput struct {
+	ID                  int64
+	UserID              int64
+	Mode                string
+	SourceLanguageID    int16
+	TargetLanguageID    int16
+	TopicID             *int64
+	LevelID             *int64
+	TotalQuestions      int16
+	CorrectQuestions    int16
+	AnsweredQuestions   int
+	WrongAnswers        int
+	UnansweredQuestions int
+	AccuracyPercentage  float64
+	DurationSeconds     int
+	StartedAt           time.Time
+	EndedAt             time.Time
+	OptionCount         int16
+	QuestionTimeLimitMs *int
+	NewAchievements     []statsawardachievements.EarnedAchievement // Earned by completing the session
+}
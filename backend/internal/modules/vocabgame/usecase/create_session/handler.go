@@ -3,21 +3,35 @@ package create_session
 import (
 	"context"
 	"math/rand"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	reviewdomain "github.com/english-coach/backend/internal/modules/review/domain"
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
 	"github.com/english-coach/backend/internal/shared/constants"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/textnorm"
+	"github.com/english-coach/backend/internal/shared/uow"
 )
 
+// distractorCandidatesPerOption is the number of ranked distractors fetched from the dictionary per wrong answer
+const distractorCandidatesPerOption = 3
+
 // Handler handles vocabgame session creation
 type Handler struct {
-	sessionRepo  domain.GameSessionRepository
-	questionRepo domain.GameQuestionRepository
-	wordRepo     dictdomain.WordRepository
-	logger       logger.ILogger
+	sessionRepo       domain.GameSessionRepository
+	questionRepo      domain.GameQuestionRepository
+	wordRepo          dictdomain.WordRepository
+	senseRepo         dictdomain.SenseRepository
+	exampleRepo       dictdomain.ExampleRepository
+	pronunciationRepo dictdomain.PronunciationRepository
+	reviewRepo        reviewdomain.ReviewStateRepository
+	uow               uow.UnitOfWork
+	logger            logger.ILogger
 }
 
 // NewHandler creates a new use case
@@ -25,13 +39,23 @@ func NewHandler(
 	sessionRepo domain.GameSessionRepository,
 	questionRepo domain.GameQuestionRepository,
 	wordRepo dictdomain.WordRepository,
+	senseRepo dictdomain.SenseRepository,
+	exampleRepo dictdomain.ExampleRepository,
+	pronunciationRepo dictdomain.PronunciationRepository,
+	reviewRepo reviewdomain.ReviewStateRepository,
+	uow uow.UnitOfWork,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
-		sessionRepo:  sessionRepo,
-		questionRepo: questionRepo,
-		wordRepo:     wordRepo,
-		logger:       logger,
+		sessionRepo:       sessionRepo,
+		questionRepo:      questionRepo,
+		wordRepo:          wordRepo,
+		senseRepo:         senseRepo,
+		exampleRepo:       exampleRepo,
+		pronunciationRepo: pronunciationRepo,
+		reviewRepo:        reviewRepo,
+		uow:               uow,
+		logger:            logger,
 	}
 }
 
@@ -44,80 +68,112 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 
 	// Create vocabgame session model
 	// Note: TopicID is kept for backward compatibility with DB schema, but we use TopicIDs array for filtering
+	// Only 'level' sessions have a level; review sessions are built from due words and have no topic
 	var topicID *int64
-	if len(input.TopicIDs) > 0 {
+	if len(input.TopicIDs) > 0 && input.Mode != domain.GameModeReview {
 		// Store first topic ID for DB compatibility (schema still has single topic_id)
 		topicID = &input.TopicIDs[0]
 	}
-	levelID := &input.LevelID
+	var levelID *int64
+	if input.Mode == domain.GameModeLevel && input.LevelID > 0 {
+		levelID = &input.LevelID
+	}
 
 	session := &domain.GameSession{
-		UserID:           userID,
-		Mode:             input.Mode,
-		SourceLanguageID: input.SourceLanguageID,
-		TargetLanguageID: input.TargetLanguageID,
-		TopicID:          topicID,
-		LevelID:          levelID,
-		TotalQuestions:   0, // Will be set when questions are generated
-		CorrectQuestions: 0,
-		StartedAt:        time.Now(),
-	}
-
-	// Save session to database first (needed for question generation)
-	if err := h.sessionRepo.Create(ctx, session); err != nil {
-		h.logger.Error("failed to create vocabgame session",
-			logger.Error(err),
-			logger.Int64("user_id", userID),
-			logger.String("mode", input.Mode),
-		)
-		return nil, sharederrors.MapDomainErrorToAppError(err)
+		UserID:              userID,
+		Mode:                input.Mode,
+		SourceLanguageID:    input.SourceLanguageID,
+		TargetLanguageID:    input.TargetLanguageID,
+		TopicID:             topicID,
+		LevelID:             levelID,
+		TotalQuestions:      0, // Will be set when questions are generated
+		CorrectQuestions:    0,
+		StartedAt:           time.Now(),
+		OptionCount:         int16(input.EffectiveOptionCount()),
+		QuestionTimeLimitMs: input.QuestionTimeLimitMs,
+		ChallengeDate:       input.ChallengeDate,
 	}
 
-	// Generate questions upfront - request up to MaxGameQuestionCount (20)
-	questions, options, err := h.generateQuestions(
-		ctx,
-		session.ID,
-		input.SourceLanguageID,
-		input.TargetLanguageID,
-		input.Mode,
-		input.TopicIDs,
-		input.LevelID,
-		constants.MaxGameQuestionCount,
-	)
-	if err != nil {
-		h.logger.Error("failed to generate questions",
-			logger.Error(err),
-			logger.Int64("session_id", session.ID),
-			logger.String("mode", input.Mode),
-			logger.Int("source_language_id", int(input.SourceLanguageID)),
-			logger.Int("target_language_id", int(input.TargetLanguageID)),
-			logger.Any("topic_ids", input.TopicIDs),
-			logger.Any("level_id", input.LevelID),
-		)
-		return nil, sharederrors.MapDomainErrorToAppError(err)
+	// Sessions with a seed, such as the daily challenge, get the same questions and options every time
+	seed := time.Now().UnixNano()
+	if input.Seed != nil {
+		seed = *input.Seed
 	}
+	rng := rand.New(rand.NewSource(seed))
 
-	// Check if we have at least the minimum required questions (1)
-	if len(questions) < constants.MinGameQuestionCount {
-		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrInsufficientWords)
-	}
+	// Create the session, its questions and options in one transaction so that a failed
+	// generation never leaves an empty session behind
+	var questions []*domain.GameQuestion
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		// Save session to database first (needed for question generation)
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			if err != domain.ErrDailyChallengePlayed {
+				h.logger.Error("failed to create vocabgame session",
+					logger.Error(err),
+					logger.Int64("user_id", userID),
+					logger.String("mode", input.Mode),
+				)
+			}
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
 
-	// Save questions and options
-	if err := h.questionRepo.CreateBatch(ctx, questions, options); err != nil {
-		h.logger.Error("failed to save questions",
-			logger.Error(err),
-			logger.Int64("session_id", session.ID),
+		// Generate questions upfront - request the chosen count, up to MaxGameQuestionCount (20)
+		var err error
+		questions, err = h.generateQuestions(
+			ctx,
+			rng,
+			session.ID,
+			userID,
+			input.SourceLanguageID,
+			input.TargetLanguageID,
+			input.Mode,
+			input.TopicIDs,
+			input.LevelID,
+			input.QuestionTypes,
+			input.SourceWordIDs,
+			input.EffectiveQuestionCount(),
+			input.EffectiveOptionCount(),
 		)
-		return nil, sharederrors.MapDomainErrorToAppError(err)
-	}
+		if err != nil {
+			h.logger.Error("failed to generate questions",
+				logger.Error(err),
+				logger.Int64("session_id", session.ID),
+				logger.String("mode", input.Mode),
+				logger.Int("source_language_id", int(input.SourceLanguageID)),
+				logger.Int("target_language_id", int(input.TargetLanguageID)),
+				logger.Any("topic_ids", input.TopicIDs),
+				logger.Any("level_id", input.LevelID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
 
-	// Update session with question count
-	session.TotalQuestions = int16(len(questions))
-	if err := h.sessionRepo.Update(ctx, session); err != nil {
-		h.logger.Error("failed to update session with question count",
-			logger.Error(err),
-			logger.Int64("session_id", session.ID),
-		)
+		// Check if we have at least the minimum required questions (1)
+		if len(questions) < constants.MinGameQuestionCount {
+			return sharederrors.MapDomainErrorToAppError(domain.ErrInsufficientWords)
+		}
+
+		// Save questions and options
+		if err := h.questionRepo.CreateBatch(ctx, questions); err != nil {
+			h.logger.Error("failed to save questions",
+				logger.Error(err),
+				logger.Int64("session_id", session.ID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		// Update session with question count
+		session.TotalQuestions = int16(len(questions))
+		if err := h.sessionRepo.Update(ctx, session); err != nil {
+			h.logger.Error("failed to update session with question count",
+				logger.Error(err),
+				logger.Int64("session_id", session.ID),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
 		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
 
@@ -132,17 +188,20 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 	)
 
 	return &CreateSessionOutput{
-		ID:               session.ID,
-		UserID:           session.UserID,
-		Mode:             session.Mode,
-		SourceLanguageID: session.SourceLanguageID,
-		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
-		LevelID:          session.LevelID,
-		TotalQuestions:   session.TotalQuestions,
-		CorrectQuestions: session.CorrectQuestions,
-		StartedAt:        session.StartedAt,
-		EndedAt:          session.EndedAt,
+		ID:                  session.ID,
+		UserID:              session.UserID,
+		Mode:                session.Mode,
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		TopicID:             session.TopicID,
+		LevelID:             session.LevelID,
+		TotalQuestions:      session.TotalQuestions,
+		CorrectQuestions:    session.CorrectQuestions,
+		StartedAt:           session.StartedAt,
+		EndedAt:             session.EndedAt,
+		OptionCount:         session.OptionCount,
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+		ChallengeDate:       session.ChallengeDate,
 	}, nil
 }
 
@@ -150,61 +209,274 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 // This method encapsulates the question generation logic
 func (h *Handler) generateQuestions(
 	ctx context.Context,
-	sessionID int64,
+	rng *rand.Rand,
+	sessionID, userID int64,
 	sourceLanguageID, targetLanguageID int16,
 	mode string,
 	topicIDs []int64,
 	levelID int64,
-	questionCount int,
-) ([]*domain.GameQuestion, []*domain.GameQuestionOption, error) {
+	questionTypes []string,
+	sourceWordIDs []int64,
+	questionCount, optionCount int,
+) ([]*domain.GameQuestion, error) {
 	startTime := time.Now()
 
 	// Validate mode
 	if err := h.validateMode(mode); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Fetch source words
-	sourceWords, err := h.fetchSourceWords(ctx, levelID, topicIDs, sourceLanguageID, targetLanguageID, questionCount)
+	// Fetch source words (and, for review sessions, other studied words used as distractors)
+	var sourceWords, distractorWords []*dictdomain.Word
+	var err error
+	switch {
+	case len(sourceWordIDs) > 0:
+		sourceWords, err = h.fetchGivenWords(ctx, sourceWordIDs)
+	case mode == domain.GameModeReview:
+		sourceWords, distractorWords, err = h.fetchReviewWords(ctx, userID, sourceLanguageID, targetLanguageID, questionCount)
+	default:
+		sourceWords, err = h.fetchSourceWords(ctx, mode, levelID, topicIDs, sourceLanguageID, targetLanguageID, questionCount)
+	}
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Select and shuffle words
-	selectedWords := h.selectAndShuffleWords(sourceWords, questionCount)
+	selectedWords := h.selectAndShuffleWords(rng, sourceWords, questionCount)
 
 	// Build questions and collect target words
-	questions, allTargetWords, sourceWordTranslations, err := h.buildQuestions(ctx, sessionID, selectedWords, sourceLanguageID, targetLanguageID)
+	// Only 'level' sessions prefer the senses of their level
+	preferredLevelID := int64(0)
+	if mode == domain.GameModeLevel {
+		preferredLevelID = levelID
+	}
+	questions, allTargetWords, sourceWordTranslations, err := h.buildQuestions(ctx, sessionID, selectedWords, sourceLanguageID, targetLanguageID, preferredLevelID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	if len(questions) == 0 {
-		return nil, nil, domain.ErrInsufficientWords
+		return nil, domain.ErrInsufficientWords
+	}
+
+	// Widen the pool of wrong answers when the questions alone do not provide enough
+	if err := h.addDistractorTranslations(ctx, distractorWords, targetLanguageID, len(questions)+optionCount-1, allTargetWords); err != nil {
+		return nil, err
+	}
+
+	// Every fetched source word can be the wrong answer of a question asking for a source word
+	sourceWordMap := make(map[int64]*dictdomain.Word, len(sourceWords)+len(distractorWords))
+	for _, word := range sourceWords {
+		sourceWordMap[word.ID] = word
+	}
+	for _, word := range distractorWords {
+		sourceWordMap[word.ID] = word
+	}
+
+	// Turn questions into the requested question types
+	if err := h.applyQuestionTypes(ctx, questions, questionTypes, sourceWordMap, allTargetWords, sourceLanguageID); err != nil {
+		return nil, err
 	}
 
-	// Generate options for each question
-	options, err := h.generateOptions(questions, allTargetWords, sourceWordTranslations)
+	// Generate options for each multiple-choice question
+	questions, err = h.generateOptions(ctx, rng, questions, optionCount, allTargetWords, sourceWordMap, sourceWordTranslations)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, domain.ErrInsufficientWords
 	}
 
 	// Log generation performance
 	h.logGenerationPerformance(startTime, sessionID, len(questions))
 
-	return questions, options, nil
+	return questions, nil
 }
 
 // validateMode validates the vocabgame mode
 func (h *Handler) validateMode(mode string) error {
-	if mode != "level" {
+	if !domain.IsValidGameMode(mode) {
 		return domain.ErrInvalidMode
 	}
 	return nil
 }
 
-// fetchSourceWords fetches source words from the repository
+// findWordsByTopics fetches words of each topic and merges them, alternating between topics
+// so that every topic is represented, and dropping words shared by several topics
+func (h *Handler) findWordsByTopics(
+	ctx context.Context,
+	topicIDs []int64,
+	sourceLanguageID, targetLanguageID int16,
+	limit int,
+) ([]*dictdomain.Word, error) {
+	wordsByTopic := make([][]*dictdomain.Word, 0, len(topicIDs))
+	for _, topicID := range topicIDs {
+		words, err := h.wordRepo.FindWordsByTopicAndLanguages(ctx, topicID, sourceLanguageID, targetLanguageID, limit)
+		if err != nil {
+			return nil, err
+		}
+		wordsByTopic = append(wordsByTopic, words)
+	}
+
+	merged := make([]*dictdomain.Word, 0, limit)
+	seen := make(map[int64]bool)
+	for i := 0; len(merged) < limit; i++ {
+		added := false
+		for _, words := range wordsByTopic {
+			if i >= len(words) {
+				continue
+			}
+			added = true
+			if word := words[i]; !seen[word.ID] && len(merged) < limit {
+				seen[word.ID] = true
+				merged = append(merged, word)
+			}
+		}
+		if !added {
+			break
+		}
+	}
+
+	return merged, nil
+}
+
+// fetchReviewWords fetches the words due for review of a user.
+// Scheduled words that are not due yet are returned separately to be used as distractors.
+func (h *Handler) fetchReviewWords(
+	ctx context.Context,
+	userID int64,
+	sourceLanguageID, targetLanguageID int16,
+	questionCount int,
+) ([]*dictdomain.Word, []*dictdomain.Word, error) {
+	// Fetch up to questionCount*3 words to have options for wrong answers
+	maxWordsToFetch := questionCount * 3
+	if maxWordsToFetch > 60 { // Cap at 60 (20*3) to avoid excessive queries
+		maxWordsToFetch = 60
+	}
+
+	reviewWords, err := h.reviewRepo.FindReviewWordsByLanguages(
+		ctx, userID, sourceLanguageID, targetLanguageID, time.Now(), maxWordsToFetch,
+	)
+	if err != nil {
+		h.logger.Error("failed to fetch review words",
+			logger.Error(err),
+			logger.String("mode", domain.GameModeReview),
+			logger.Int64("user_id", userID),
+			logger.Int("source_language_id", int(sourceLanguageID)),
+			logger.Int("target_language_id", int(targetLanguageID)),
+			logger.Int("requested_limit", maxWordsToFetch),
+		)
+		return nil, nil, err
+	}
+
+	dueWordIDs := make(map[int64]bool)
+	wordIDs := make([]int64, 0, len(reviewWords))
+	for _, reviewWord := range reviewWords {
+		// Due words come first; only keep as many as there are questions
+		if reviewWord.IsDue && len(dueWordIDs) < questionCount {
+			dueWordIDs[reviewWord.WordID] = true
+		}
+		wordIDs = append(wordIDs, reviewWord.WordID)
+	}
+
+	if len(dueWordIDs) < 1 {
+		h.logger.Info("no words due for review",
+			logger.Int64("user_id", userID),
+			logger.Int("source_language_id", int(sourceLanguageID)),
+			logger.Int("target_language_id", int(targetLanguageID)),
+		)
+		return nil, nil, domain.ErrNoDueReviews
+	}
+
+	words, err := h.wordRepo.FindWordsByIDs(ctx, wordIDs)
+	if err != nil {
+		h.logger.Error("failed to fetch review words by IDs",
+			logger.Error(err),
+			logger.Int64("user_id", userID),
+			logger.Int("word_count", len(wordIDs)),
+		)
+		return nil, nil, err
+	}
+
+	dueWords := make([]*dictdomain.Word, 0, len(dueWordIDs))
+	distractorWords := make([]*dictdomain.Word, 0, len(words))
+	for _, word := range words {
+		if dueWordIDs[word.ID] {
+			dueWords = append(dueWords, word)
+		} else {
+			distractorWords = append(distractorWords, word)
+		}
+	}
+
+	h.logger.Info("fetched words due for review",
+		logger.Int64("user_id", userID),
+		logger.Int("source_language_id", int(sourceLanguageID)),
+		logger.Int("target_language_id", int(targetLanguageID)),
+		logger.Int("due_count", len(dueWords)),
+		logger.Int("distractor_count", len(distractorWords)),
+	)
+
+	if len(dueWords) < 1 {
+		return nil, nil, domain.ErrNoDueReviews
+	}
+
+	return dueWords, distractorWords, nil
+}
+
+// fetchGivenWords fetches the source words a session was asked to be built from
+func (h *Handler) fetchGivenWords(ctx context.Context, wordIDs []int64) ([]*dictdomain.Word, error) {
+	words, err := h.wordRepo.FindWordsByIDs(ctx, wordIDs)
+	if err != nil {
+		h.logger.Error("failed to fetch given source words",
+			logger.Error(err),
+			logger.Int("word_count", len(wordIDs)),
+		)
+		return nil, err
+	}
+
+	if len(words) < 1 {
+		h.logger.Warn("none of the given source words exist",
+			logger.Any("word_ids", wordIDs),
+		)
+		return nil, domain.ErrInsufficientWords
+	}
+
+	return words, nil
+}
+
+// addDistractorTranslations adds translations of extra words to the pool of wrong answers
+// until it holds poolSize words
+func (h *Handler) addDistractorTranslations(
+	ctx context.Context,
+	distractorWords []*dictdomain.Word,
+	targetLanguageID int16,
+	poolSize int,
+	allTargetWords map[int64]*dictdomain.Word,
+) error {
+	for _, word := range distractorWords {
+		if len(allTargetWords) >= poolSize {
+			break
+		}
+
+		translations, err := h.wordRepo.FindTranslationsForWord(ctx, word.ID, targetLanguageID, 1)
+		if err != nil {
+			h.logger.Error("failed to find translations for distractor word",
+				logger.Error(err),
+				logger.Int64("word_id", word.ID),
+				logger.Int("target_language_id", int(targetLanguageID)),
+			)
+			return err
+		}
+		for _, trans := range translations {
+			allTargetWords[trans.ID] = trans
+		}
+	}
+	return nil
+}
+
+// fetchSourceWords fetches source words from the repository according to the mode
 func (h *Handler) fetchSourceWords(
 	ctx context.Context,
+	mode string,
 	levelID int64,
 	topicIDs []int64,
 	sourceLanguageID, targetLanguageID int16,
@@ -216,13 +488,24 @@ func (h *Handler) fetchSourceWords(
 		maxWordsToFetch = 60
 	}
 
-	sourceWords, err := h.wordRepo.FindWordsByLevelAndTopicsAndLanguages(
-		ctx, levelID, topicIDs, sourceLanguageID, targetLanguageID, maxWordsToFetch,
-	)
+	var sourceWords []*dictdomain.Word
+	var err error
+	switch mode {
+	case domain.GameModeTopic:
+		sourceWords, err = h.findWordsByTopics(ctx, topicIDs, sourceLanguageID, targetLanguageID, maxWordsToFetch)
+	case domain.GameModeMixed, domain.GameModeDaily:
+		sourceWords, err = h.wordRepo.FindWordsAcrossLevelsAndLanguages(
+			ctx, topicIDs, sourceLanguageID, targetLanguageID, maxWordsToFetch,
+		)
+	default:
+		sourceWords, err = h.wordRepo.FindWordsByLevelAndTopicsAndLanguages(
+			ctx, levelID, topicIDs, sourceLanguageID, targetLanguageID, maxWordsToFetch,
+		)
+	}
 	if err != nil {
-		h.logger.Error("failed to fetch source words by level and topics",
+		h.logger.Error("failed to fetch source words",
 			logger.Error(err),
-			logger.String("mode", "level"),
+			logger.String("mode", mode),
 			logger.Int64("level_id", levelID),
 			logger.Any("topic_ids", topicIDs),
 			logger.Int("source_language_id", int(sourceLanguageID)),
@@ -232,7 +515,8 @@ func (h *Handler) fetchSourceWords(
 		return nil, err
 	}
 
-	h.logger.Info("fetched words by level and topics",
+	h.logger.Info("fetched source words",
+		logger.String("mode", mode),
 		logger.Int64("level_id", levelID),
 		logger.Any("topic_ids", topicIDs),
 		logger.Int("source_language_id", int(sourceLanguageID)),
@@ -244,7 +528,7 @@ func (h *Handler) fetchSourceWords(
 	// Check if we have at least 1 word (minimum required)
 	if len(sourceWords) < 1 {
 		h.logger.Warn("no words available for question generation",
-			logger.String("mode", "level"),
+			logger.String("mode", mode),
 			logger.Int("requested", questionCount),
 			logger.Int("available", len(sourceWords)),
 			logger.Any("topic_ids", topicIDs),
@@ -259,9 +543,9 @@ func (h *Handler) fetchSourceWords(
 }
 
 // selectAndShuffleWords selects and shuffles words for randomness
-func (h *Handler) selectAndShuffleWords(sourceWords []*dictdomain.Word, questionCount int) []*dictdomain.Word {
+func (h *Handler) selectAndShuffleWords(rng *rand.Rand, sourceWords []*dictdomain.Word, questionCount int) []*dictdomain.Word {
 	// Shuffle words for randomness
-	rand.Shuffle(len(sourceWords), func(i, j int) {
+	rng.Shuffle(len(sourceWords), func(i, j int) {
 		sourceWords[i], sourceWords[j] = sourceWords[j], sourceWords[i]
 	})
 
@@ -279,25 +563,39 @@ func (h *Handler) selectAndShuffleWords(sourceWords []*dictdomain.Word, question
 	return sourceWords[:wordsToSelect]
 }
 
-// buildQuestions builds questions from selected words and collects target words
+// buildQuestions builds one question per selected word, testing a single sense of the word,
+// and collects target words. The correct answer and the translations excluded from wrong answers
+// come from the tested sense only.
 func (h *Handler) buildQuestions(
 	ctx context.Context,
 	sessionID int64,
 	selectedWords []*dictdomain.Word,
 	sourceLanguageID, targetLanguageID int16,
+	preferredLevelID int64,
 ) ([]*domain.GameQuestion, map[int64]*dictdomain.Word, map[int64][]int64, error) {
 	questions := make([]*domain.GameQuestion, 0, len(selectedWords))
 	allTargetWords := make(map[int64]*dictdomain.Word)
-	// Map từ sourceWordID -> danh sách tất cả translation IDs của nó
+	// Map từ sourceWordID -> danh sách tất cả translation IDs của sense được hỏi
 	sourceWordTranslations := make(map[int64][]int64)
 	questionOrder := int16(0)
 	wordsWithoutTranslation := 0
 
+	wordIDs := make([]int64, 0, len(selectedWords))
 	for _, sourceWord := range selectedWords {
-		// Get correct translation
-		translations, err := h.wordRepo.FindTranslationsForWord(
-			ctx, sourceWord.ID, targetLanguageID, 10,
+		wordIDs = append(wordIDs, sourceWord.ID)
+	}
+	sensesByWord, err := h.senseRepo.FindSensesByWordIDs(ctx, wordIDs)
+	if err != nil {
+		h.logger.Error("failed to find senses for words",
+			logger.Error(err),
+			logger.Int("word_count", len(wordIDs)),
 		)
+		return nil, nil, nil, err
+	}
+
+	for _, sourceWord := range selectedWords {
+		// Pick the tested sense and get its translations
+		sense, translations, err := h.pickSense(ctx, sensesByWord[sourceWord.ID], targetLanguageID, preferredLevelID)
 		if err != nil {
 			h.logger.Error("failed to find translations for word",
 				logger.Error(err),
@@ -306,7 +604,7 @@ func (h *Handler) buildQuestions(
 			)
 			return nil, nil, nil, err
 		}
-		if len(translations) == 0 {
+		if sense == nil {
 			wordsWithoutTranslation++
 			h.logger.Warn("no translations found for word",
 				logger.Int64("word_id", sourceWord.ID),
@@ -319,7 +617,7 @@ func (h *Handler) buildQuestions(
 		correctWord := translations[0] // Use first translation as correct answer
 		allTargetWords[correctWord.ID] = correctWord
 
-		// Lưu tất cả translation IDs của sourceWord này (bao gồm cả correctWord)
+		// Lưu tất cả translation IDs của sense được hỏi (bao gồm cả correctWord)
 		translationIDs := make([]int64, len(translations))
 		for i, trans := range translations {
 			translationIDs[i] = trans.ID
@@ -331,11 +629,13 @@ func (h *Handler) buildQuestions(
 		questionOrder++
 
 		// Create question
+		senseID := sense.ID
 		question := &domain.GameQuestion{
 			SessionID:           sessionID,
 			QuestionOrder:       questionOrder,
-			QuestionType:        "word_to_translation",
+			QuestionType:        domain.QuestionTypeWordToTranslation,
 			SourceWordID:        sourceWord.ID,
+			SourceSenseID:       &senseID,
 			CorrectTargetWordID: correctWord.ID,
 			SourceLanguageID:    sourceLanguageID,
 			TargetLanguageID:    targetLanguageID,
@@ -353,52 +653,415 @@ func (h *Handler) buildQuestions(
 	return questions, allTargetWords, sourceWordTranslations, nil
 }
 
-// generateOptions generates options (A, B, C, D) for each question
-func (h *Handler) generateOptions(
+// applyQuestionTypes assigns the requested question types to the questions in turn and sets their prompts.
+// Questions keep the 'word_to_translation' type when the dictionary lacks the data for the assigned type.
+func (h *Handler) applyQuestionTypes(
+	ctx context.Context,
 	questions []*domain.GameQuestion,
+	questionTypes []string,
+	sourceWordMap map[int64]*dictdomain.Word,
 	allTargetWords map[int64]*dictdomain.Word,
+	sourceLanguageID int16,
+) error {
+	if len(questionTypes) == 0 {
+		return nil
+	}
+
+	prompts, err := h.loadPrompts(ctx, questions, questionTypes, sourceWordMap, sourceLanguageID)
+	if err != nil {
+		return err
+	}
+
+	for i, question := range questions {
+		questionType := questionTypes[i%len(questionTypes)]
+		if questionType == domain.QuestionTypeWordToTranslation {
+			continue
+		}
+		if questionType == domain.QuestionTypeTypedTranslation {
+			// The source word is the prompt and every translation is accepted, nothing else is needed
+			question.QuestionType = questionType
+			continue
+		}
+
+		var prompt questionPrompt
+		var ok bool
+		if questionType == domain.QuestionTypeTranslationToWord {
+			// The correct translation becomes the prompt
+			var translation *dictdomain.Word
+			translation, ok = allTargetWords[question.CorrectTargetWordID]
+			if ok {
+				prompt.Text = translation.Lemma
+			}
+		} else {
+			prompt, ok = prompts[questionType][question.SourceWordID]
+		}
+
+		if !ok {
+			h.logger.Info("question type not available for word, keeping word_to_translation",
+				logger.Int64("word_id", question.SourceWordID),
+				logger.String("question_type", questionType),
+			)
+			continue
+		}
+
+		question.QuestionType = questionType
+		question.PromptText = &prompt.Text
+		question.CorrectTargetWordID = question.SourceWordID
+		if prompt.SenseID != nil {
+			// The tested sense is the one the prompt describes, which may not be the one first chosen
+			question.SourceSenseID = prompt.SenseID
+		}
+	}
+
+	return nil
+}
+
+// questionPrompt is the prompt of a question and the sense it describes, nil if it describes no sense
+type questionPrompt struct {
+	Text    string
+	SenseID *int64
+}
+
+// loadPrompts loads the dictionary data needed by the requested question types and returns
+// the prompt of each source word, keyed by question type then source word ID
+func (h *Handler) loadPrompts(
+	ctx context.Context,
+	questions []*domain.GameQuestion,
+	questionTypes []string,
+	sourceWordMap map[int64]*dictdomain.Word,
+	sourceLanguageID int16,
+) (map[string]map[int64]questionPrompt, error) {
+	requested := make(map[string]bool, len(questionTypes))
+	for _, questionType := range questionTypes {
+		requested[questionType] = true
+	}
+
+	wordIDs := make([]int64, 0, len(questions))
+	questionSenses := make(map[int64]int64, len(questions))
+	for _, question := range questions {
+		wordIDs = append(wordIDs, question.SourceWordID)
+		if question.SourceSenseID != nil {
+			questionSenses[question.SourceWordID] = *question.SourceSenseID
+		}
+	}
+
+	prompts := make(map[string]map[int64]questionPrompt)
+
+	if requested[domain.QuestionTypeDefinitionToWord] || requested[domain.QuestionTypeExampleCloze] {
+		sensesByWord, err := h.senseRepo.FindSensesByWordIDs(ctx, wordIDs)
+		if err != nil {
+			h.logger.Error("failed to find senses for question prompts",
+				logger.Error(err),
+				logger.Int("word_count", len(wordIDs)),
+			)
+			return nil, err
+		}
+		// Prompts describe the tested sense when it has the needed data, another sense otherwise
+		for wordID, senses := range sensesByWord {
+			sensesByWord[wordID] = preferSense(senses, questionSenses[wordID])
+		}
+
+		if requested[domain.QuestionTypeDefinitionToWord] {
+			prompts[domain.QuestionTypeDefinitionToWord] = definitionPrompts(sensesByWord, sourceWordMap)
+		}
+
+		if requested[domain.QuestionTypeExampleCloze] {
+			senseIDs := make([]int64, 0)
+			for _, senses := range sensesByWord {
+				for _, sense := range senses {
+					senseIDs = append(senseIDs, sense.ID)
+				}
+			}
+
+			examplesBySense, err := h.exampleRepo.FindExamplesBySenseIDs(ctx, senseIDs)
+			if err != nil {
+				h.logger.Error("failed to find examples for question prompts",
+					logger.Error(err),
+					logger.Int("sense_count", len(senseIDs)),
+				)
+				return nil, err
+			}
+			prompts[domain.QuestionTypeExampleCloze] = clozePrompts(sensesByWord, examplesBySense, sourceWordMap, sourceLanguageID)
+		}
+	}
+
+	if requested[domain.QuestionTypePronunciationToWord] {
+		pronunciationsByWord, err := h.pronunciationRepo.FindPronunciationsByWordIDs(ctx, wordIDs)
+		if err != nil {
+			h.logger.Error("failed to find pronunciations for question prompts",
+				logger.Error(err),
+				logger.Int("word_count", len(wordIDs)),
+			)
+			return nil, err
+		}
+		prompts[domain.QuestionTypePronunciationToWord] = pronunciationPrompts(pronunciationsByWord)
+	}
+
+	return prompts, nil
+}
+
+// preferSense returns the senses with the given sense first, keeping the order of the others
+func preferSense(senses []*dictdomain.Sense, senseID int64) []*dictdomain.Sense {
+	ordered := make([]*dictdomain.Sense, 0, len(senses))
+	for _, sense := range senses {
+		if sense.ID == senseID {
+			ordered = append(ordered, sense)
+		}
+	}
+	for _, sense := range senses {
+		if sense.ID != senseID {
+			ordered = append(ordered, sense)
+		}
+	}
+	return ordered
+}
+
+// definitionPrompts returns the first definition of each word that does not give away the lemma
+func definitionPrompts(sensesByWord map[int64][]*dictdomain.Sense, sourceWordMap map[int64]*dictdomain.Word) map[int64]questionPrompt {
+	prompts := make(map[int64]questionPrompt)
+	for wordID, senses := range sensesByWord {
+		word, ok := sourceWordMap[wordID]
+		if !ok {
+			continue
+		}
+		lemmaPattern := lemmaRegexp(word.Lemma)
+		for _, sense := range senses {
+			definition := strings.TrimSpace(sense.Definition)
+			if definition != "" && !lemmaPattern.MatchString(definition) {
+				senseID := sense.ID
+				prompts[wordID] = questionPrompt{Text: definition, SenseID: &senseID}
+				break
+			}
+		}
+	}
+	return prompts
+}
+
+// clozePrompts returns, for each word, the first example sentence in the source language
+// that contains the lemma, with the lemma replaced by a blank
+func clozePrompts(
+	sensesByWord map[int64][]*dictdomain.Sense,
+	examplesBySense map[int64][]*dictdomain.Example,
+	sourceWordMap map[int64]*dictdomain.Word,
+	sourceLanguageID int16,
+) map[int64]questionPrompt {
+	prompts := make(map[int64]questionPrompt)
+	for wordID, senses := range sensesByWord {
+		word, ok := sourceWordMap[wordID]
+		if !ok {
+			continue
+		}
+		lemmaPattern := lemmaRegexp(word.Lemma)
+	senseLoop:
+		for _, sense := range senses {
+			for _, example := range examplesBySense[sense.ID] {
+				if example.LanguageID != sourceLanguageID || !lemmaPattern.MatchString(example.Content) {
+					continue
+				}
+				senseID := sense.ID
+				prompts[wordID] = questionPrompt{
+					Text:    lemmaPattern.ReplaceAllString(example.Content, "${1}"+clozeBlank+"${2}"),
+					SenseID: &senseID,
+				}
+				break senseLoop
+			}
+		}
+	}
+	return prompts
+}
+
+// pronunciationPrompts returns the first IPA transcription of each word
+func pronunciationPrompts(pronunciationsByWord map[int64][]*dictdomain.Pronunciation) map[int64]questionPrompt {
+	prompts := make(map[int64]questionPrompt)
+	for wordID, pronunciations := range pronunciationsByWord {
+		for _, pronunciation := range pronunciations {
+			if pronunciation.IPA != nil && strings.TrimSpace(*pronunciation.IPA) != "" {
+				prompts[wordID] = questionPrompt{Text: strings.TrimSpace(*pronunciation.IPA)}
+				break
+			}
+		}
+	}
+	return prompts
+}
+
+// clozeBlank replaces the lemma in example_cloze prompts
+const clozeBlank = "____"
+
+// lemmaRegexp matches the lemma as a whole word, case-insensitively.
+// The characters around the lemma are captured so that they can be kept when blanking it.
+func lemmaRegexp(lemma string) *regexp.Regexp {
+	return regexp.MustCompile(`(^|[^\p{L}\p{N}])(?i:` + regexp.QuoteMeta(lemma) + `)($|[^\p{L}\p{N}])`)
+}
+
+// getSourceWrongCandidates gets source words that can be wrong answers of a question asking for
+// its source word: other words with the same lemma, and for 'translation_to_word' other words
+// sharing a translation with the source word, are excluded as they would also be correct
+func (h *Handler) getSourceWrongCandidates(
+	question *domain.GameQuestion,
+	questionType string,
+	sourceWordList []*dictdomain.Word,
+	sourceWordMap map[int64]*dictdomain.Word,
 	sourceWordTranslations map[int64][]int64,
-) ([]*domain.GameQuestionOption, error) {
-	options := make([]*domain.GameQuestionOption, 0, len(questions)*4)
+) []*dictdomain.Word {
+	sourceWord, ok := sourceWordMap[question.SourceWordID]
+	if !ok {
+		return nil
+	}
+
+	translationIDs := make(map[int64]bool)
+	if questionType == domain.QuestionTypeTranslationToWord {
+		for _, transID := range sourceWordTranslations[question.SourceWordID] {
+			translationIDs[transID] = true
+		}
+	}
+
+	wrongCandidates := make([]*dictdomain.Word, 0)
+	for _, word := range sourceWordList {
+		if word.ID == sourceWord.ID || strings.EqualFold(word.Lemma, sourceWord.Lemma) {
+			continue
+		}
+		sharesTranslation := false
+		for _, transID := range sourceWordTranslations[word.ID] {
+			if translationIDs[transID] {
+				sharesTranslation = true
+				break
+			}
+		}
+		if !sharesTranslation {
+			wrongCandidates = append(wrongCandidates, word)
+		}
+	}
+	return wrongCandidates
+}
+
+// pickSense picks the sense of a word to test and returns it with its translations.
+// Senses of the preferred level come first, then senses in sense_order; the first sense
+// translated into the target language is picked. It returns a nil sense if there is none.
+func (h *Handler) pickSense(
+	ctx context.Context,
+	senses []*dictdomain.Sense,
+	targetLanguageID int16,
+	preferredLevelID int64,
+) (*dictdomain.Sense, []*dictdomain.Word, error) {
+	ordered := make([]*dictdomain.Sense, 0, len(senses))
+	if preferredLevelID > 0 {
+		for _, sense := range senses {
+			if sense.LevelID != nil && *sense.LevelID == preferredLevelID {
+				ordered = append(ordered, sense)
+			}
+		}
+	}
+	for _, sense := range senses {
+		if preferredLevelID <= 0 || sense.LevelID == nil || *sense.LevelID != preferredLevelID {
+			ordered = append(ordered, sense)
+		}
+	}
 
-	// Convert map to slice for easier iteration
-	targetWordList := make([]*dictdomain.Word, 0, len(allTargetWords))
-	for _, word := range allTargetWords {
-		targetWordList = append(targetWordList, word)
+	for _, sense := range ordered {
+		translations, err := h.wordRepo.FindTranslationsForSense(ctx, sense.ID, targetLanguageID, 10)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(translations) > 0 {
+			return sense, translations, nil
+		}
 	}
 
+	return nil, nil, nil
+}
+
+// generateOptions generates up to optionCount options for each multiple-choice question and attaches them to it.
+// Wrong answers come from the dictionary's distractor ranking first and from the session's own words after.
+// Questions without any possible wrong answer are dropped and the kept questions are renumbered.
+// Typed questions have no options.
+func (h *Handler) generateOptions(
+	ctx context.Context,
+	rng *rand.Rand,
+	questions []*domain.GameQuestion,
+	optionCount int,
+	allTargetWords map[int64]*dictdomain.Word,
+	sourceWordMap map[int64]*dictdomain.Word,
+	sourceWordTranslations map[int64][]int64,
+) ([]*domain.GameQuestion, error) {
+	wrongAnswerCount := optionCount - 1
+
+	// Convert maps to slices sorted by ID, so that a seeded session always shuffles the same order
+	targetWordList := sortedWords(allTargetWords)
+	sourceWordList := sortedWords(sourceWordMap)
+
+	kept := make([]*domain.GameQuestion, 0, len(questions))
 	for _, question := range questions {
-		// Get correct word
-		correctWord, exists := allTargetWords[question.CorrectTargetWordID]
-		if !exists {
-			return nil, domain.ErrQuestionNotFound
+		if domain.IsTypedAnswer(question.QuestionType) {
+			kept = append(kept, question)
+			continue
 		}
 
-		// Get all translations of the source word (to exclude them from wrong answers)
-		excludedWordIDs := make(map[int64]bool)
-		if translationIDs, ok := sourceWordTranslations[question.SourceWordID]; ok {
-			for _, transID := range translationIDs {
+		var correctWord *dictdomain.Word
+		var poolCandidates []*dictdomain.Word
+		var excludedIDs, excludedTranslationIDs []int64
+		if domain.AnswersWithSourceWord(question.QuestionType) {
+			// Questions asking for the source word take their options from source words
+			correctWord = sourceWordMap[question.SourceWordID]
+			poolCandidates = h.getSourceWrongCandidates(question, question.QuestionType, sourceWordList, sourceWordMap, sourceWordTranslations)
+			if question.QuestionType == domain.QuestionTypeTranslationToWord {
+				// A word translated into the prompt would also be correct
+				excludedTranslationIDs = sourceWordTranslations[question.SourceWordID]
+			}
+		} else {
+			correctWord = allTargetWords[question.CorrectTargetWordID]
+			// Every translation of the tested sense is correct, none of them can be a wrong answer
+			excludedIDs = sourceWordTranslations[question.SourceWordID]
+			excludedWordIDs := make(map[int64]bool, len(excludedIDs))
+			for _, transID := range excludedIDs {
 				excludedWordIDs[transID] = true
 			}
+			poolCandidates = h.getWrongAnswerCandidates(targetWordList, excludedWordIDs)
+		}
+		if correctWord == nil {
+			return nil, domain.ErrQuestionNotFound
 		}
 
-		// Get wrong answer candidates - exclude ALL translations of the source word
-		wrongCandidates := h.getWrongAnswerCandidates(targetWordList, excludedWordIDs)
+		rankedCandidates, err := h.wordRepo.FindDistractorWords(ctx, correctWord.ID, excludedIDs, excludedTranslationIDs, wrongAnswerCount*distractorCandidatesPerOption)
+		if err != nil {
+			// The session's own words are still usable as wrong answers
+			h.logger.Warn("failed to find distractor words, using session words only",
+				logger.Error(err),
+				logger.Int64("word_id", correctWord.ID),
+			)
+			rankedCandidates = nil
+		}
 
-		// Ensure we have at least 3 wrong candidates
-		if len(wrongCandidates) < 3 {
-			wrongCandidates = h.padWrongCandidates(wrongCandidates)
-			if len(wrongCandidates) < 3 {
-				return nil, domain.ErrOptionNotFound
-			}
+		wrongAnswers := selectDistractors(rng, correctWord, rankedCandidates, poolCandidates, wrongAnswerCount)
+		if len(wrongAnswers) == 0 {
+			h.logger.Warn("no wrong answer available, dropping question",
+				logger.Int64("word_id", question.SourceWordID),
+				logger.String("question_type", question.QuestionType),
+			)
+			continue
 		}
 
 		// Create options for this question
-		questionOptions := h.createQuestionOptions(question, correctWord, wrongCandidates)
-		options = append(options, questionOptions...)
+		question.Options = h.createQuestionOptions(rng, question, correctWord, wrongAnswers)
+		kept = append(kept, question)
 	}
 
-	return options, nil
+	for i, question := range kept {
+		question.QuestionOrder = int16(i + 1)
+	}
+
+	return kept, nil
+}
+
+// sortedWords returns the words of a map sorted by ID
+func sortedWords(wordMap map[int64]*dictdomain.Word) []*dictdomain.Word {
+	words := make([]*dictdomain.Word, 0, len(wordMap))
+	for _, word := range wordMap {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		return words[i].ID < words[j].ID
+	})
+	return words
 }
 
 // getWrongAnswerCandidates gets wrong answer candidates excluding all translations of the source word
@@ -412,36 +1075,60 @@ func (h *Handler) getWrongAnswerCandidates(targetWordList []*dictdomain.Word, ex
 	return wrongCandidates
 }
 
-// padWrongCandidates pads wrong candidates if not enough are available
-func (h *Handler) padWrongCandidates(wrongCandidates []*dictdomain.Word) []*dictdomain.Word {
-	// If not enough, pad with duplicates if needed
-	for len(wrongCandidates) < 3 {
-		if len(wrongCandidates) > 0 {
-			wrongCandidates = append(wrongCandidates, wrongCandidates[0])
-		} else {
-			break
+// selectDistractors picks up to count wrong answers. The best ranked distractors come first, shuffled so
+// that a word does not always get the same options, then the remaining ranked ones and the shuffled pool.
+// A word whose normalized lemma matches the correct answer or an already picked wrong answer is skipped,
+// so a question never shows the same option twice.
+func selectDistractors(rng *rand.Rand, correctWord *dictdomain.Word, ranked, pool []*dictdomain.Word, count int) []*dictdomain.Word {
+	best := make([]*dictdomain.Word, 0, len(ranked))
+	best = append(best, ranked...)
+	bestCount := count * 2
+	if bestCount > len(best) {
+		bestCount = len(best)
+	}
+	rng.Shuffle(bestCount, func(i, j int) {
+		best[i], best[j] = best[j], best[i]
+	})
+
+	shuffledPool := make([]*dictdomain.Word, 0, len(pool))
+	shuffledPool = append(shuffledPool, pool...)
+	rng.Shuffle(len(shuffledPool), func(i, j int) {
+		shuffledPool[i], shuffledPool[j] = shuffledPool[j], shuffledPool[i]
+	})
+
+	seenIDs := map[int64]bool{correctWord.ID: true}
+	seenLemmas := map[string]bool{textnorm.Normalize(correctWord.Lemma): true}
+	selected := make([]*dictdomain.Word, 0, count)
+	for _, candidates := range [][]*dictdomain.Word{best, shuffledPool} {
+		for _, word := range candidates {
+			if len(selected) == count {
+				return selected
+			}
+			lemma := textnorm.Normalize(word.Lemma)
+			if seenIDs[word.ID] || seenLemmas[lemma] {
+				continue
+			}
+			seenIDs[word.ID] = true
+			seenLemmas[lemma] = true
+			selected = append(selected, word)
 		}
 	}
-	return wrongCandidates
+	return selected
 }
 
-// createQuestionOptions creates options (A, B, C, D) for a question
+// createQuestionOptions creates the options of a question from its correct answer and wrong answers,
+// labelled A, B, C... in shuffled order
 func (h *Handler) createQuestionOptions(
+	rng *rand.Rand,
 	question *domain.GameQuestion,
 	correctWord *dictdomain.Word,
-	wrongCandidates []*dictdomain.Word,
+	wrongAnswers []*dictdomain.Word,
 ) []*domain.GameQuestionOption {
-	// Shuffle wrong candidates
-	rand.Shuffle(len(wrongCandidates), func(i, j int) {
-		wrongCandidates[i], wrongCandidates[j] = wrongCandidates[j], wrongCandidates[i]
-	})
-
-	// Select 3 wrong answers
-	selectedWrong := wrongCandidates[:3]
-
 	// Combine correct + wrong answers and shuffle
-	allAnswers := []*dictdomain.Word{correctWord, selectedWrong[0], selectedWrong[1], selectedWrong[2]}
-	rand.Shuffle(len(allAnswers), func(i, j int) {
+	allAnswers := make([]*dictdomain.Word, 0, len(wrongAnswers)+1)
+	allAnswers = append(allAnswers, correctWord)
+	allAnswers = append(allAnswers, wrongAnswers...)
+	rng.Shuffle(len(allAnswers), func(i, j int) {
 		allAnswers[i], allAnswers[j] = allAnswers[j], allAnswers[i]
 	})
 
@@ -456,13 +1143,11 @@ func (h *Handler) createQuestionOptions(
 		correctIndex = 0 // Fallback to first option
 	}
 
-	// Create options (A, B, C, D)
-	labels := []string{"A", "B", "C", "D"}
-	options := make([]*domain.GameQuestionOption, 0, 4)
+	options := make([]*domain.GameQuestionOption, 0, len(allAnswers))
 	for j, word := range allAnswers {
 		option := &domain.GameQuestionOption{
 			QuestionID:   question.ID, // Will be set after question is saved
-			OptionLabel:  labels[j],
+			OptionLabel:  string(rune('A' + j)),
 			TargetWordID: word.ID,
 			IsCorrect:    j == correctIndex,
 		}
@@ -0,0 +1,126 @@
+package create_session
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/textnorm"
+)
+
+func testWords(firstID int64, lemmas ...string) []*dictdomain.Word {
+	words := make([]*dictdomain.Word, len(lemmas))
+	for i, lemma := range lemmas {
+		words[i] = &dictdomain.Word{ID: firstID + int64(i), Lemma: lemma}
+	}
+	return words
+}
+
+func wordIDs(words []*dictdomain.Word) []int64 {
+	ids := make([]int64, len(words))
+	for i, word := range words {
+		ids[i] = word.ID
+	}
+	return ids
+}
+
+func TestSelectDistractors(t *testing.T) {
+	correctWord := &dictdomain.Word{ID: 1, Lemma: "apple"}
+
+	tests := []struct {
+		name    string
+		ranked  []*dictdomain.Word
+		pool    []*dictdomain.Word
+		count   int
+		wantLen int
+		allowed []int64 // IDs the distractors must be taken from, nil means any
+	}{
+		{
+			name:    "best ranked words only",
+			ranked:  testWords(10, "pear", "plum", "peach", "grape", "lemon", "lime", "melon", "kiwi"),
+			pool:    testWords(100, "car", "house", "river"),
+			count:   3,
+			wantLen: 3,
+			allowed: []int64{10, 11, 12, 13, 14, 15},
+		},
+		{
+			name:    "pool completes the ranked words",
+			ranked:  testWords(10, "pear"),
+			pool:    testWords(100, "car", "house", "river"),
+			count:   3,
+			wantLen: 3,
+			allowed: []int64{10, 100, 101, 102},
+		},
+		{
+			name:    "pool only",
+			pool:    testWords(100, "car", "house", "river", "tree"),
+			count:   3,
+			wantLen: 3,
+		},
+		{
+			name:    "not enough candidates",
+			ranked:  testWords(10, "pear"),
+			pool:    testWords(100, "car"),
+			count:   3,
+			wantLen: 2,
+		},
+		{
+			name:    "correct word and its spellings skipped",
+			ranked:  []*dictdomain.Word{correctWord, {ID: 2, Lemma: "Apple"}, {ID: 3, Lemma: "ápple!"}, {ID: 10, Lemma: "pear"}},
+			count:   3,
+			wantLen: 1,
+			allowed: []int64{10},
+		},
+		{
+			name:    "duplicate lemmas and words shown once",
+			ranked:  testWords(10, "pear", "Pear", "plum"),
+			pool:    []*dictdomain.Word{{ID: 10, Lemma: "pear"}, {ID: 11, Lemma: "pear"}, {ID: 100, Lemma: "plum"}},
+			count:   3,
+			wantLen: 2,
+		},
+		{
+			name:    "no distractors wanted",
+			ranked:  testWords(10, "pear", "plum"),
+			count:   0,
+			wantLen: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				got := selectDistractors(rand.New(rand.NewSource(seed)), correctWord, tt.ranked, tt.pool, tt.count)
+
+				if len(got) != tt.wantLen {
+					t.Fatalf("seed %d: got %d distractors %v, want %d", seed, len(got), wordIDs(got), tt.wantLen)
+				}
+
+				lemmas := map[string]bool{textnorm.Normalize(correctWord.Lemma): true}
+				for _, word := range got {
+					lemma := textnorm.Normalize(word.Lemma)
+					if word.ID == correctWord.ID || lemmas[lemma] {
+						t.Fatalf("seed %d: distractors %v repeat an option", seed, wordIDs(got))
+					}
+					lemmas[lemma] = true
+
+					if tt.allowed != nil && !slices.Contains(tt.allowed, word.ID) {
+						t.Fatalf("seed %d: distractor %d not among %v", seed, word.ID, tt.allowed)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSelectDistractorsSameSeedSameDistractors(t *testing.T) {
+	correctWord := &dictdomain.Word{ID: 1, Lemma: "apple"}
+	ranked := testWords(10, "pear", "plum", "peach", "grape", "lemon", "lime", "melon", "kiwi")
+	pool := testWords(100, "car", "house", "river", "tree", "road")
+
+	first := selectDistractors(rand.New(rand.NewSource(42)), correctWord, ranked, pool, 3)
+	second := selectDistractors(rand.New(rand.NewSource(42)), correctWord, ranked, pool, 3)
+	if !slices.Equal(wordIDs(first), wordIDs(second)) {
+		t.Errorf("same seed gave distractors %v and %v", wordIDs(first), wordIDs(second))
+	}
+}
@@ -2,15 +2,42 @@ package create_session
 
 import (
 	"errors"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
 )
 
 // CreateSessionInput represents the input to create a vocabgame session use case.
 type CreateSessionInput struct {
-	SourceLanguageID int16
-	TargetLanguageID int16
-	Mode             string  // Always 'level' now
-	LevelID          int64   // Required
-	TopicIDs         []int64 // Optional array (empty/nil means all topics)
+	SourceLanguageID    int16
+	TargetLanguageID    int16
+	Mode                string     // 'level', 'topic', 'mixed', 'review' or 'daily'
+	LevelID             int64      // Required for 'level' mode
+	TopicIDs            []int64    // Required for 'topic' mode, optional otherwise (empty/nil means all topics)
+	QuestionTypes       []string   // Optional, questions alternate between the given types (empty/nil means 'word_to_translation')
+	QuestionCount       int        // Optional, 0 means constants.MaxGameQuestionCount
+	OptionCount         int        // Optional, options per multiple-choice question, 0 means constants.DefaultGameOptionCount
+	QuestionTimeLimitMs *int       // Optional, time allowed to answer each question; nil means no time limit
+	SourceWordIDs       []int64    // Optional, asks exactly these source words instead of picking them by mode (retry sessions)
+	Seed                *int64     // Optional, generates the same questions and options for the same seed; nil means random
+	ChallengeDate       *time.Time // Required for 'daily' mode, the day of the daily challenge
+}
+
+// EffectiveQuestionCount returns the number of questions to generate
+func (r *CreateSessionInput) EffectiveQuestionCount() int {
+	if r.QuestionCount == 0 {
+		return constants.MaxGameQuestionCount
+	}
+	return r.QuestionCount
+}
+
+// EffectiveOptionCount returns the number of options per multiple-choice question
+func (r *CreateSessionInput) EffectiveOptionCount() int {
+	if r.OptionCount == 0 {
+		return constants.DefaultGameOptionCount
+	}
+	return r.OptionCount
 }
 
 // Validate validates the CreateSessionInput.
@@ -20,17 +47,27 @@ func (r *CreateSessionInput) Validate() error {
 		return errors.New("Ngôn ngữ nguồn và ngôn ngữ đích phải khác nhau")
 	}
 
-	// Mode must be 'level'
-	if r.Mode != "level" {
-		return errors.New("Chế độ phải là 'level'")
+	// Mode must be 'level', 'topic', 'mixed' or 'review'; daily challenges are only created for a day, never on request
+	if !domain.IsValidGameMode(r.Mode) || (r.Mode == domain.GameModeDaily && r.ChallengeDate == nil) {
+		return errors.New("Chế độ phải là 'level', 'topic', 'mixed' hoặc 'review'")
 	}
 
-	// Level ID is required
-	if r.LevelID <= 0 {
+	// Level ID is required for 'level' mode; other modes are not tied to a level.
+	// Sessions on given source words keep the mode of the session they come from, without its level or topic.
+	if r.Mode == domain.GameModeLevel && r.LevelID <= 0 && len(r.SourceWordIDs) == 0 {
 		return errors.New("Level_id là bắt buộc và phải lớn hơn 0")
 	}
 
-	// TopicIDs is optional (empty array or nil means all topics)
+	// At least one topic is required for 'topic' mode
+	if r.Mode == domain.GameModeTopic && len(r.TopicIDs) == 0 && len(r.SourceWordIDs) == 0 {
+		return errors.New("Topic_ids là bắt buộc ở chế độ 'topic'")
+	}
+
+	// If provided, source words must fit in a session
+	if len(r.SourceWordIDs) > constants.MaxGameQuestionCount {
+		return errors.New("Số từ tối đa là 20")
+	}
+
 	// If provided, all topic IDs must be valid
 	for _, topicID := range r.TopicIDs {
 		if topicID <= 0 {
@@ -38,6 +75,27 @@ func (r *CreateSessionInput) Validate() error {
 		}
 	}
 
+	// If provided, all question types must be supported
+	for _, questionType := range r.QuestionTypes {
+		if !domain.IsValidQuestionType(questionType) {
+			return errors.New("Loại câu hỏi phải là 'word_to_translation', 'translation_to_word', 'definition_to_word', 'example_cloze', 'pronunciation_to_word' hoặc 'typed_translation'")
+		}
+	}
+
+	// If provided, question count must be within the allowed range
+	if r.QuestionCount != 0 && (r.QuestionCount < constants.MinGameQuestionCount || r.QuestionCount > constants.MaxGameQuestionCount) {
+		return errors.New("Số câu hỏi phải từ 1 đến 20")
+	}
+
+	// If provided, option count must be within the allowed range
+	if r.OptionCount != 0 && (r.OptionCount < constants.MinGameOptionCount || r.OptionCount > constants.MaxGameOptionCount) {
+		return errors.New("Số lựa chọn phải từ 2 đến 6")
+	}
+
+	// If provided, time limit must be within the allowed range
+	if r.QuestionTimeLimitMs != nil && (*r.QuestionTimeLimitMs < constants.MinQuestionTimeLimitMs || *r.QuestionTimeLimitMs > constants.MaxQuestionTimeLimitMs) {
+		return errors.New("Thời gian cho mỗi câu hỏi phải từ 3000 đến 300000 ms")
+	}
+
 	return nil
 }
-
@@ -0,0 +1,70 @@
+package finish_duel
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecompletesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/complete_session"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler finishes a duel
+type Handler struct {
+	duelRepo          domain.DuelRepository
+	completeSessionUC *gamecompletesession.Handler
+	logger            logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	duelRepo domain.DuelRepository,
+	completeSessionUC *gamecompletesession.Handler,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		duelRepo:          duelRepo,
+		completeSessionUC: completeSessionUC,
+		logger:            logger,
+	}
+}
+
+// Execute ends the session of every player, ranks the players by points and stores the result.
+// Sessions already ended by their last answer are left as they are.
+func (h *Handler) Execute(ctx context.Context, input FinishDuelInput) (*FinishDuelOutput, error) {
+	duel := input.Duel
+
+	for _, player := range duel.Players {
+		if _, err := h.completeSessionUC.Execute(ctx, gamecompletesession.CompleteSessionInput{
+			SessionID: player.SessionID,
+			UserID:    player.UserID,
+		}); err != nil {
+			// The duel result does not depend on the session, it is stored regardless
+			h.logger.Error("failed to complete duel session",
+				logger.Error(err),
+				logger.Int64("duel_id", duel.ID),
+				logger.Int64("session_id", player.SessionID),
+			)
+		}
+	}
+
+	domain.RankDuelPlayers(duel.Players)
+	endedAt := time.Now()
+	duel.EndedAt = &endedAt
+
+	if err := h.duelRepo.Finish(ctx, duel); err != nil {
+		h.logger.Error("failed to finish duel",
+			logger.Error(err),
+			logger.Int64("duel_id", duel.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.logger.Info("vocabgame duel finished",
+		logger.Int64("duel_id", duel.ID),
+		logger.String("room_code", duel.RoomCode),
+	)
+
+	return &FinishDuelOutput{Duel: duel}, nil
+}
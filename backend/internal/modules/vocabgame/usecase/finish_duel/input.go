@@ -0,0 +1,8 @@
+package finish_duel
+
+import "github.com/english-coach/backend/internal/modules/vocabgame/domain"
+
+// FinishDuelInput represents the input to finish a duel use case.
+type FinishDuelInput struct {
+	Duel *domain.Duel // Duel with the points scored by each player
+}
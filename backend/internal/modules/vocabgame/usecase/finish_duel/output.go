@@ -0,0 +1,8 @@
+package finish_duel
+
+import "github.com/english-coach/backend/internal/modules/vocabgame/domain"
+
+// FinishDuelOutput represents the output for finishing a duel use case.
+type FinishDuelOutput struct {
+	Duel *domain.Duel // Players are ranked, the winners first
+}
@@ -0,0 +1,95 @@
+package get_next_question
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler finds where a learner left off in a vocabgame session
+type Handler struct {
+	sessionRepo  domain.GameSessionRepository
+	questionRepo domain.GameQuestionRepository
+	answerRepo   domain.GameAnswerRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo domain.GameSessionRepository,
+	questionRepo domain.GameQuestionRepository,
+	answerRepo domain.GameAnswerRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:  sessionRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		logger:       logger,
+	}
+}
+
+// Execute returns the open question of the session, see domain.GameSession.OpenQuestion, with the progress so far.
+// Ended sessions, sessions whose questions are all answered and timed sessions whose remaining questions
+// all expired have no next question.
+func (h *Handler) Execute(ctx context.Context, input GetNextQuestionInput) (*GetNextQuestionOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotFound)
+	}
+
+	// Verify user owns session
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
+	}
+
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, session.ID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, session.ID, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &GetNextQuestionOutput{
+		Session:           session,
+		AnsweredQuestions: len(answers),
+		TotalQuestions:    len(questions),
+	}
+
+	for _, answer := range answers {
+		if answer.IsCorrect {
+			output.CorrectAnswers++
+		}
+	}
+
+	if session.EndedAt != nil {
+		return output, nil
+	}
+
+	// Questions are ordered by question_order
+	output.Question, output.AnswerDeadline = session.OpenQuestion(questions, answers, time.Now())
+
+	return output, nil
+}
@@ -0,0 +1,7 @@
+package get_next_question
+
+// GetNextQuestionInput represents the input to get the next unanswered question of a session use case.
+type GetNextQuestionInput struct {
+	SessionID int64
+	UserID    int64
+}
@@ -0,0 +1,17 @@
+package get_next_question
+
+import (
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+)
+
+// GetNextQuestionOutput represents the next unanswered question of a session and the progress so far.
+type GetNextQuestionOutput struct {
+	Session           *domain.GameSession
+	Question          *domain.GameQuestion // nil when every question is answered or the session has ended
+	AnsweredQuestions int
+	TotalQuestions    int
+	CorrectAnswers    int
+	AnswerDeadline    *time.Time // Only set for an open question of a time-limited session
+}
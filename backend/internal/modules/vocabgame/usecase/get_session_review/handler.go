@@ -0,0 +1,211 @@
+package get_session_review
+
+import (
+	"context"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	getworddetail "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler builds the post-session review of a vocabgame session
+type Handler struct {
+	sessionRepo  domain.GameSessionRepository
+	questionRepo domain.GameQuestionRepository
+	answerRepo   domain.GameAnswerRepository
+	wordRepo     dictdomain.WordRepository
+	wordDetailUC *getworddetail.Handler
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo domain.GameSessionRepository,
+	questionRepo domain.GameQuestionRepository,
+	answerRepo domain.GameAnswerRepository,
+	wordRepo dictdomain.WordRepository,
+	wordDetailUC *getworddetail.Handler,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:  sessionRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		wordRepo:     wordRepo,
+		wordDetailUC: wordDetailUC,
+		logger:       logger,
+	}
+}
+
+// Execute reveals the correct option and the learner's answer of every question of an ended session,
+// with the tested sense, examples and pronunciations of each missed word.
+// Sessions still in play cannot be reviewed, since the review gives the answers away.
+func (h *Handler) Execute(ctx context.Context, input GetSessionReviewInput) (*GetSessionReviewOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotFound)
+	}
+
+	// Verify user owns session
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
+	}
+
+	if session.EndedAt == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotEnded)
+	}
+
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, session.ID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, session.ID, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+			logger.Int64("user_id", input.UserID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answerMap := make(map[int64]*domain.GameAnswer, len(answers))
+	for _, answer := range answers {
+		answerMap[answer.QuestionID] = answer
+	}
+
+	wordMap, err := h.loadWords(ctx, questions)
+	if err != nil {
+		h.logger.Error("failed to load session words",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &GetSessionReviewOutput{
+		Session:   session,
+		Questions: make([]ReviewQuestion, 0, len(questions)),
+	}
+
+	// Missed words may repeat across questions, their detail is fetched once
+	details := make(map[int64]*getworddetail.GetWordDetailOutput)
+	for _, q := range questions {
+		answer := answerMap[q.ID]
+		review := ReviewQuestion{
+			Question:    q,
+			SourceWord:  wordMap[q.SourceWordID],
+			CorrectWord: wordMap[q.CorrectTargetWordID],
+			Options:     make([]ReviewOption, 0, len(q.Options)),
+			Answer:      answer,
+			Missed:      answer == nil || !answer.IsCorrect,
+		}
+
+		for _, opt := range q.Options {
+			review.Options = append(review.Options, ReviewOption{
+				Option:    opt,
+				Word:      wordMap[opt.TargetWordID],
+				IsCorrect: q.IsCorrectOption(opt),
+				Selected:  answer != nil && answer.SelectedOptionID != nil && *answer.SelectedOptionID == opt.ID,
+			})
+		}
+
+		if answer != nil {
+			output.AnsweredQuestions++
+			if answer.IsCorrect {
+				output.CorrectAnswers++
+			}
+		}
+
+		if review.Missed {
+			detail, ok := details[q.SourceWordID]
+			if !ok {
+				detail = h.loadWordDetail(ctx, q.SourceWordID)
+				details[q.SourceWordID] = detail
+			}
+			if detail != nil {
+				review.Sense = testedSense(detail, q.SourceSenseID)
+				review.Pronunciations = detail.Pronunciations
+			}
+		}
+
+		output.Questions = append(output.Questions, review)
+	}
+
+	return output, nil
+}
+
+// loadWords fetches the source, correct and option words of the questions in one batch
+func (h *Handler) loadWords(ctx context.Context, questions []*domain.GameQuestion) (map[int64]*dictdomain.Word, error) {
+	wordIDs := make(map[int64]bool)
+	for _, q := range questions {
+		wordIDs[q.SourceWordID] = true
+		wordIDs[q.CorrectTargetWordID] = true
+		for _, opt := range q.Options {
+			wordIDs[opt.TargetWordID] = true
+		}
+	}
+
+	wordMap := make(map[int64]*dictdomain.Word, len(wordIDs))
+	if len(wordIDs) == 0 {
+		return wordMap, nil
+	}
+
+	wordIDList := make([]int64, 0, len(wordIDs))
+	for id := range wordIDs {
+		wordIDList = append(wordIDList, id)
+	}
+
+	words, err := h.wordRepo.FindWordsByIDs(ctx, wordIDList)
+	if err != nil {
+		return nil, err
+	}
+	for _, word := range words {
+		wordMap[word.ID] = word
+	}
+
+	return wordMap, nil
+}
+
+// loadWordDetail fetches the dictionary detail of a missed word.
+// The review is still useful without it, so failures are logged and yield nil.
+func (h *Handler) loadWordDetail(ctx context.Context, wordID int64) *getworddetail.GetWordDetailOutput {
+	detail, err := h.wordDetailUC.Execute(ctx, getworddetail.GetWordDetailInput{WordID: wordID})
+	if err != nil {
+		h.logger.Warn("failed to fetch missed word detail",
+			logger.Error(err),
+			logger.Int64("word_id", wordID),
+		)
+		return nil
+	}
+	return detail
+}
+
+// testedSense returns the sense tested by a question, falling back to the first sense of the word
+func testedSense(detail *getworddetail.GetWordDetailOutput, senseID *int64) *getworddetail.SenseDetail {
+	if len(detail.Senses) == 0 {
+		return nil
+	}
+	if senseID != nil {
+		for i := range detail.Senses {
+			if detail.Senses[i].ID == *senseID {
+				return &detail.Senses[i]
+			}
+		}
+	}
+	return &detail.Senses[0]
+}
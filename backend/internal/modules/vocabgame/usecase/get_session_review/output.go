@@ -0,0 +1,37 @@
+package get_session_review
+
+import (
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	getworddetail "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+)
+
+// GetSessionReviewOutput represents the review of an ended session.
+type GetSessionReviewOutput struct {
+	Session           *domain.GameSession
+	AnsweredQuestions int
+	CorrectAnswers    int
+	Questions         []ReviewQuestion
+}
+
+// ReviewQuestion represents a question of the session with its correct answer and the learner's answer.
+type ReviewQuestion struct {
+	Question    *domain.GameQuestion
+	SourceWord  *dictdomain.Word
+	CorrectWord *dictdomain.Word
+	Options     []ReviewOption
+	Answer      *domain.GameAnswer // nil when the question was left unanswered
+	Missed      bool               // Answered wrongly or left unanswered
+
+	// Dictionary detail of the source word, only set for missed questions
+	Sense          *getworddetail.SenseDetail // Tested sense, or the first sense when the question has none
+	Pronunciations []*dictdomain.Pronunciation
+}
+
+// ReviewOption represents an option of a question with whether it was correct and chosen.
+type ReviewOption struct {
+	Option    *domain.GameQuestionOption
+	Word      *dictdomain.Word
+	IsCorrect bool
+	Selected  bool
+}
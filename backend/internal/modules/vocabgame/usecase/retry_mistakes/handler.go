@@ -0,0 +1,132 @@
+package retry_mistakes
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler creates a session asking again the words missed in a previous session
+type Handler struct {
+	sessionRepo     domain.GameSessionRepository
+	questionRepo    domain.GameQuestionRepository
+	answerRepo      domain.GameAnswerRepository
+	createSessionUC *gamecreatesession.Handler
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo domain.GameSessionRepository,
+	questionRepo domain.GameQuestionRepository,
+	answerRepo domain.GameAnswerRepository,
+	createSessionUC *gamecreatesession.Handler,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:     sessionRepo,
+		questionRepo:    questionRepo,
+		answerRepo:      answerRepo,
+		createSessionUC: createSessionUC,
+		logger:          logger,
+	}
+}
+
+// Execute creates a new session with the settings of the given session, asking only the source words
+// answered wrongly in it, with freshly generated options
+func (h *Handler) Execute(ctx context.Context, input RetryMistakesInput) (*gamecreatesession.CreateSessionOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotFound)
+	}
+
+	// Verify user owns session
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
+	}
+
+	missedWordIDs, err := h.answerRepo.FindMissedWordIDsBySessionID(ctx, session.ID, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find missed words",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if len(missedWordIDs) == 0 {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrNoMistakesToRetry)
+	}
+
+	questionTypes, err := h.sessionQuestionTypes(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	createInput := gamecreatesession.CreateSessionInput{
+		SourceLanguageID:    session.SourceLanguageID,
+		TargetLanguageID:    session.TargetLanguageID,
+		Mode:                session.Mode,
+		QuestionTypes:       questionTypes,
+		QuestionCount:       len(missedWordIDs),
+		OptionCount:         int(session.OptionCount),
+		QuestionTimeLimitMs: session.QuestionTimeLimitMs,
+		SourceWordIDs:       missedWordIDs,
+	}
+	// The retry of a daily challenge is a regular session, the challenge is played once a day
+	if session.Mode == domain.GameModeDaily {
+		createInput.Mode = domain.GameModeMixed
+	}
+	if session.LevelID != nil {
+		createInput.LevelID = *session.LevelID
+	}
+	if session.TopicID != nil {
+		createInput.TopicIDs = []int64{*session.TopicID}
+	}
+
+	output, err := h.createSessionUC.Execute(ctx, createInput, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("vocabgame retry session created",
+		logger.Int64("session_id", output.ID),
+		logger.Int64("retried_session_id", session.ID),
+		logger.Int64("user_id", input.UserID),
+		logger.Int("missed_word_count", len(missedWordIDs)),
+	)
+
+	return output, nil
+}
+
+// sessionQuestionTypes returns the question types of a session in order of first use,
+// so that the retry asks the words the same way
+func (h *Handler) sessionQuestionTypes(ctx context.Context, sessionID int64) ([]string, error) {
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, sessionID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", sessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	seen := make(map[string]bool)
+	questionTypes := make([]string, 0)
+	for _, q := range questions {
+		if !seen[q.QuestionType] {
+			seen[q.QuestionType] = true
+			questionTypes = append(questionTypes, q.QuestionType)
+		}
+	}
+	return questionTypes, nil
+}
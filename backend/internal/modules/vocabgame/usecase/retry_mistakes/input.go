@@ -0,0 +1,7 @@
+package retry_mistakes
+
+// RetryMistakesInput represents the input to retry the mistakes of a session use case.
+type RetryMistakesInput struct {
+	SessionID int64 // Session whose wrong answers are retried
+	UserID    int64
+}
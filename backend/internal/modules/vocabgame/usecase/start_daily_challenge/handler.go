@@ -0,0 +1,58 @@
+package start_daily_challenge
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	"github.com/english-coach/backend/internal/shared/constants"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler starts the daily challenge of a language pair
+type Handler struct {
+	createSessionUC *gamecreatesession.Handler
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	createSessionUC *gamecreatesession.Handler,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		createSessionUC: createSessionUC,
+		logger:          logger,
+	}
+}
+
+// Execute creates the user's session of today's daily challenge.
+// The questions are generated from a seed derived from the day and language pair, so every user
+// gets the same challenge; a user can only start it once a day.
+func (h *Handler) Execute(ctx context.Context, input StartDailyChallengeInput) (*gamecreatesession.CreateSessionOutput, error) {
+	day := domain.DailyChallengeDay(time.Now())
+	seed := domain.DailyChallengeSeed(day, input.SourceLanguageID, input.TargetLanguageID)
+	timeLimitMs := constants.DailyChallengeQuestionTimeLimitMs
+
+	output, err := h.createSessionUC.Execute(ctx, gamecreatesession.CreateSessionInput{
+		SourceLanguageID:    input.SourceLanguageID,
+		TargetLanguageID:    input.TargetLanguageID,
+		Mode:                domain.GameModeDaily,
+		QuestionCount:       constants.DailyChallengeQuestionCount,
+		QuestionTimeLimitMs: &timeLimitMs,
+		Seed:                &seed,
+		ChallengeDate:       &day,
+	}, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("vocabgame daily challenge started",
+		logger.Int64("session_id", output.ID),
+		logger.Int64("user_id", input.UserID),
+		logger.String("challenge_date", day.Format("2006-01-02")),
+	)
+
+	return output, nil
+}
@@ -0,0 +1,8 @@
+package start_daily_challenge
+
+// StartDailyChallengeInput represents the input to start the daily challenge use case.
+type StartDailyChallengeInput struct {
+	SourceLanguageID int16
+	TargetLanguageID int16
+	UserID           int64
+}
@@ -0,0 +1,132 @@
+package start_duel
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/uow"
+)
+
+// Handler starts a duel between the players of a room
+type Handler struct {
+	duelRepo        domain.DuelRepository
+	questionRepo    domain.GameQuestionRepository
+	createSessionUC *gamecreatesession.Handler
+	uow             uow.UnitOfWork
+	logger          logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	duelRepo domain.DuelRepository,
+	questionRepo domain.GameQuestionRepository,
+	createSessionUC *gamecreatesession.Handler,
+	uow uow.UnitOfWork,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		duelRepo:        duelRepo,
+		questionRepo:    questionRepo,
+		createSessionUC: createSessionUC,
+		uow:             uow,
+		logger:          logger,
+	}
+}
+
+// Execute creates a session for every player and records the duel.
+// The sessions are generated from one seed, so every player gets the same questions and options
+// in the same order; questions have no time limit of their own, the duel paces them.
+func (h *Handler) Execute(ctx context.Context, input StartDuelInput) (*StartDuelOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
+	}
+
+	questionCount := input.QuestionCount
+	if questionCount == 0 {
+		questionCount = constants.DuelQuestionCount
+	}
+	seed := time.Now().UnixNano()
+
+	duel := &domain.Duel{
+		RoomCode: input.RoomCode,
+		Players:  make([]*domain.DuelPlayer, 0, len(input.UserIDs)),
+	}
+	output := &StartDuelOutput{
+		Duel:      duel,
+		Questions: make(map[int64][]*domain.GameQuestion, len(input.UserIDs)),
+	}
+
+	// The sessions and the duel are created together, so a failed start leaves no session behind
+	err := h.uow.Do(ctx, func(ctx context.Context) error {
+		for _, userID := range input.UserIDs {
+			session, err := h.createSessionUC.Execute(ctx, gamecreatesession.CreateSessionInput{
+				SourceLanguageID: input.SourceLanguageID,
+				TargetLanguageID: input.TargetLanguageID,
+				Mode:             input.Mode,
+				LevelID:          input.LevelID,
+				TopicIDs:         input.TopicIDs,
+				QuestionTypes:    input.QuestionTypes,
+				QuestionCount:    questionCount,
+				OptionCount:      input.OptionCount,
+				Seed:             &seed,
+			}, userID)
+			if err != nil {
+				return err
+			}
+
+			questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, session.ID)
+			if err != nil {
+				h.logger.Error("failed to find duel session questions",
+					logger.Error(err),
+					logger.Int64("session_id", session.ID),
+				)
+				return sharederrors.MapDomainErrorToAppError(err)
+			}
+			if len(questions) == 0 {
+				return sharederrors.MapDomainErrorToAppError(domain.ErrInsufficientWords)
+			}
+
+			// Rounds pair the questions of the players by order, so every player needs as many
+			if len(duel.Players) > 0 && len(questions) != len(output.Questions[duel.Players[0].UserID]) {
+				h.logger.Warn("duel sessions generated different question counts",
+					logger.String("room_code", input.RoomCode),
+					logger.Int64("session_id", session.ID),
+					logger.Int("questions_count", len(questions)),
+				)
+				return sharederrors.MapDomainErrorToAppError(domain.ErrInsufficientWords)
+			}
+
+			duel.Players = append(duel.Players, &domain.DuelPlayer{
+				UserID:    userID,
+				SessionID: session.ID,
+			})
+			output.Questions[userID] = questions
+		}
+
+		duel.StartedAt = time.Now()
+		if err := h.duelRepo.Create(ctx, duel); err != nil {
+			h.logger.Error("failed to create duel",
+				logger.Error(err),
+				logger.String("room_code", input.RoomCode),
+			)
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.logger.Info("vocabgame duel started",
+		logger.Int64("duel_id", duel.ID),
+		logger.String("room_code", duel.RoomCode),
+		logger.Int("players", len(duel.Players)),
+	)
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package start_duel
+
+import (
+	"errors"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+)
+
+// StartDuelInput represents the input to start a duel use case.
+type StartDuelInput struct {
+	RoomCode         string
+	UserIDs          []int64 // Players of the room, the host first
+	SourceLanguageID int16
+	TargetLanguageID int16
+	Mode             string   // 'level', 'topic' or 'mixed'
+	LevelID          int64    // Required for 'level' mode
+	TopicIDs         []int64  // Required for 'topic' mode, optional otherwise
+	QuestionTypes    []string // Optional, empty/nil means 'word_to_translation'
+	QuestionCount    int      // Optional, 0 means constants.DuelQuestionCount
+	OptionCount      int      // Optional, 0 means constants.DefaultGameOptionCount
+}
+
+// Validate validates the StartDuelInput.
+// The game settings are validated by the session creation of each player.
+func (r *StartDuelInput) Validate() error {
+	if len(r.UserIDs) < constants.DuelMinPlayers || len(r.UserIDs) > constants.DuelMaxPlayers {
+		return errors.New("Trận đấu phải có từ 2 đến 8 người chơi")
+	}
+
+	// Review sessions are built from the due words of each user and daily challenges are played alone,
+	// so only modes generating the same questions for every player can be played head-to-head
+	switch r.Mode {
+	case domain.GameModeLevel, domain.GameModeTopic, domain.GameModeMixed:
+	default:
+		return errors.New("Chế độ đấu phải là 'level', 'topic' hoặc 'mixed'")
+	}
+
+	return nil
+}
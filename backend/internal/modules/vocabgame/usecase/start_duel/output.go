@@ -0,0 +1,9 @@
+package start_duel
+
+import "github.com/english-coach/backend/internal/modules/vocabgame/domain"
+
+// StartDuelOutput represents the output for starting a duel use case.
+type StartDuelOutput struct {
+	Duel      *domain.Duel
+	Questions map[int64][]*domain.GameQuestion // Questions of the session of each player by user ID, in question order
+}
@@ -2,19 +2,36 @@ package submit_answer
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	reviewrecordreview "github.com/english-coach/backend/internal/modules/review/usecase/record_review"
+	statsdomain "github.com/english-coach/backend/internal/modules/statistics/domain"
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	gamecompletesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/complete_session"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/eventbus"
 	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/uow"
 )
 
 // Handler handles answer submission
 type Handler struct {
-	answerRepo   domain.GameAnswerRepository
-	questionRepo domain.GameQuestionRepository
-	sessionRepo  domain.GameSessionRepository
-	logger       logger.ILogger
+	answerRepo        domain.GameAnswerRepository
+	questionRepo      domain.GameQuestionRepository
+	sessionRepo       domain.GameSessionRepository
+	statisticsRepo    statsdomain.UserStatisticsRepository
+	wordRepo          dictdomain.WordRepository
+	senseRepo         dictdomain.SenseRepository
+	levelRepo         dictdomain.LevelRepository
+	completeSessionUC *gamecompletesession.Handler
+	recordReviewUC    *reviewrecordreview.Handler
+	awardUC           *statsawardachievements.Handler
+	eventBus          eventbus.Bus
+	uow               uow.UnitOfWork
+	logger            logger.ILogger
 }
 
 // NewHandler creates a new use case
@@ -22,13 +39,31 @@ func NewHandler(
 	answerRepo domain.GameAnswerRepository,
 	questionRepo domain.GameQuestionRepository,
 	sessionRepo domain.GameSessionRepository,
+	statisticsRepo statsdomain.UserStatisticsRepository,
+	wordRepo dictdomain.WordRepository,
+	senseRepo dictdomain.SenseRepository,
+	levelRepo dictdomain.LevelRepository,
+	completeSessionUC *gamecompletesession.Handler,
+	recordReviewUC *reviewrecordreview.Handler,
+	awardUC *statsawardachievements.Handler,
+	eventBus eventbus.Bus,
+	uow uow.UnitOfWork,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
-		answerRepo:   answerRepo,
-		questionRepo: questionRepo,
-		sessionRepo:  sessionRepo,
-		logger:       logger,
+		answerRepo:        answerRepo,
+		questionRepo:      questionRepo,
+		sessionRepo:       sessionRepo,
+		statisticsRepo:    statisticsRepo,
+		wordRepo:          wordRepo,
+		senseRepo:         senseRepo,
+		levelRepo:         levelRepo,
+		completeSessionUC: completeSessionUC,
+		recordReviewUC:    recordReviewUC,
+		awardUC:           awardUC,
+		eventBus:          eventBus,
+		uow:               uow,
+		logger:            logger,
 	}
 }
 
@@ -49,7 +84,10 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrQuestionNotFound)
 	}
 
-	options := question.Options
+	// Validate request against the question type
+	if err := input.Validate(question.QuestionType); err != nil {
+		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
+	}
 
 	// Verify question belongs to session
 	if question.SessionID != sessionID {
@@ -77,22 +115,31 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
 	}
 
-	// Find the selected option
-	var selectedOption *domain.GameQuestionOption
+	// Grade the answer: typed questions against every accepted translation, others by the selected option
 	var isCorrect bool
-	for _, opt := range options {
-		if opt.ID == input.SelectedOptionID {
-			selectedOption = opt
-			isCorrect = opt.IsCorrect
-			break
+	var grade *typedAnswerGrade
+	if domain.IsTypedAnswer(question.QuestionType) {
+		grade, err = h.gradeTypedAnswer(ctx, question, *input.AnswerText)
+		if err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+		isCorrect = grade.MatchResult != domain.MatchResultWrong
+	} else {
+		var selectedOption *domain.GameQuestionOption
+		for _, opt := range question.Options {
+			if opt.ID == *input.SelectedOptionID {
+				selectedOption = opt
+				isCorrect = question.IsCorrectOption(opt)
+				break
+			}
 		}
-	}
 
-	if selectedOption == nil {
-		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrOptionNotFound)
+		if selectedOption == nil {
+			return nil, sharederrors.MapDomainErrorToAppError(domain.ErrOptionNotFound)
+		}
 	}
 
-	// Check if answer already exists
+	// Check if answer already exists; concurrent submissions are caught by the unique constraint on insert
 	existingAnswer, err := h.answerRepo.FindGameAnswerByQuestionID(ctx, input.QuestionID, sessionID, userID)
 	if err != nil {
 		// If not found, it's a normal case (answer doesn't exist yet, allow submission)
@@ -113,35 +160,108 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrAnswerAlreadySubmitted)
 	}
 
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, session.ID, userID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+			logger.Int64("user_id", userID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	// Reject answers arriving after the time limit of the question, nothing is stored
+	if err := h.checkAnswerDeadline(ctx, session, question, answers, userID); err != nil {
+		return nil, err
+	}
+
 	// Create answer
 	answer := &domain.GameAnswer{
 		QuestionID:       input.QuestionID,
 		SessionID:        sessionID,
 		UserID:           userID,
-		SelectedOptionID: &input.SelectedOptionID,
+		SelectedOptionID: input.SelectedOptionID,
 		IsCorrect:        isCorrect,
 		ResponseTimeMs:   input.ResponseTimeMs,
 		AnsweredAt:       time.Now(),
 	}
-
-	if err := h.answerRepo.Create(ctx, answer); err != nil {
-		h.logger.Error("failed to create answer",
-			logger.Error(err),
-			logger.Int64("question_id", input.QuestionID),
-		)
-		return nil, sharederrors.MapDomainErrorToAppError(err)
+	if grade != nil {
+		answerText := strings.TrimSpace(*input.AnswerText)
+		answer.SelectedOptionID = nil
+		answer.AnswerText = &answerText
+		answer.MatchResult = &grade.MatchResult
 	}
 
-	// Update session correct count if answer is correct
+	// Correct answers earn experience weighted by the difficulty of the word and the answer speed,
+	// measured by the server from when the question was shown: the time sent by the client is not trusted
+	var xpEarned int
 	if isCorrect {
-		session.CorrectQuestions++
-		if err := h.sessionRepo.Update(ctx, session); err != nil {
-			h.logger.Error("failed to update session correct count",
+		elapsedMs := int(time.Since(session.QuestionShownAt(answers)) / time.Millisecond)
+		xpEarned = statsdomain.AnswerXP(true, h.answerDifficulty(ctx, session, question), &elapsedMs, session.QuestionTimeLimitMs)
+	}
+
+	// The answer, the completion it may bring, the lifetime aggregates and the review schedule
+	// are stored together, or not at all
+	var summary *gamecompletesession.CompleteSessionOutput
+	var endedNow bool
+	var review *reviewrecordreview.RecordReviewOutput
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		// Store the answer and update the session correct count in one statement
+		correctQuestions, err := h.answerRepo.Create(ctx, answer)
+		if err != nil {
+			if err != domain.ErrAnswerAlreadySubmitted {
+				h.logger.Error("failed to create answer",
+					logger.Error(err),
+					logger.Int64("question_id", input.QuestionID),
+				)
+			}
+			return sharederrors.MapDomainErrorToAppError(err)
+		}
+		session.CorrectQuestions = correctQuestions
+
+		// Auto-complete the session once the last question has been answered
+		summary, endedNow, err = h.completeIfLastQuestion(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		// Update lifetime aggregates for the answer and, if it ended the session, the completion
+		activity := statsdomain.Activity{
+			UserID: userID,
+			Answer: &statsdomain.AnswerActivity{
+				WordID:           question.SourceWordID,
+				IsCorrect:        isCorrect,
+				AnsweredAt:       answer.AnsweredAt,
+				SourceLanguageID: session.SourceLanguageID,
+				TargetLanguageID: session.TargetLanguageID,
+				LevelID:          session.LevelID,
+				XP:               xpEarned,
+			},
+		}
+		if endedNow {
+			activity.CompletedSession = gamecompletesession.SessionActivity(summary)
+		}
+		if err := h.statisticsRepo.RecordActivity(ctx, activity); err != nil {
+			h.logger.Error("failed to record answer statistics",
 				logger.Error(err),
-				logger.Int64("session_id", sessionID),
+				logger.Int64("question_id", input.QuestionID),
+				logger.Int64("user_id", userID),
 			)
-			return nil, sharederrors.MapDomainErrorToAppError(err)
+			return sharederrors.MapDomainErrorToAppError(err)
 		}
+
+		// Reschedule the studied word for spaced-repetition review, after the aggregates created its row
+		review, err = h.recordReviewUC.Execute(ctx, reviewrecordreview.RecordReviewInput{
+			UserID:         userID,
+			WordID:         question.SourceWordID,
+			IsCorrect:      isCorrect,
+			ResponseTimeMs: input.ResponseTimeMs,
+			ReviewedAt:     answer.AnsweredAt,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
 
 	// Log answer submission
@@ -155,9 +275,18 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 	if input.ResponseTimeMs != nil {
 		fields = append(fields, logger.Int("response_time_ms", *input.ResponseTimeMs))
 	}
+	if grade != nil {
+		fields = append(fields, logger.String("match_result", grade.MatchResult))
+	}
 	h.logger.Info("answer submitted", fields...)
 
-	return &SubmitAnswerOutput{
+	// Watchers see the answer before the session ended with it
+	h.publishAnswerRecorded(ctx, session, question, answer)
+	if endedNow {
+		h.completeSessionUC.PublishSessionEnded(ctx, summary)
+	}
+
+	output := &SubmitAnswerOutput{
 		ID:               answer.ID,
 		QuestionID:       answer.QuestionID,
 		SessionID:        answer.SessionID,
@@ -165,6 +294,218 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		SelectedOptionID: answer.SelectedOptionID,
 		IsCorrect:        answer.IsCorrect,
 		ResponseTimeMs:   answer.ResponseTimeMs,
+		AnswerText:       answer.AnswerText,
+		MatchResult:      answer.MatchResult,
+		AnsweredAt:       answer.AnsweredAt,
+		XPEarned:         xpEarned,
+		NextReviewAt:     &review.DueAt,
+	}
+	if grade != nil {
+		output.ExpectedAnswer = &grade.ExpectedAnswer
+	}
+	if summary != nil {
+		output.SessionCompleted = true
+		output.Summary = summary
+	}
+
+	// The recorded answer may have earned mastery, streak or, with the completion, level session achievements
+	awarded, err := h.awardUC.Execute(ctx, statsawardachievements.AwardAchievementsInput{UserID: userID})
+	if err != nil {
+		// The answer is already stored, achievements are awarded again on the next activity
+		h.logger.Error("failed to award achievements",
+			logger.Error(err),
+			logger.Int64("answer_id", answer.ID),
+			logger.Int64("user_id", userID),
+		)
+	} else {
+		output.NewAchievements = awarded.Earned
+	}
+
+	return output, nil
+}
+
+// answerDifficulty returns the difficulty order of the level of an answered word: the level of the session,
+// or for sessions without a level the level of the tested sense. Difficulty only weights experience,
+// so failures are logged and yield the easiest difficulty.
+func (h *Handler) answerDifficulty(ctx context.Context, session *domain.GameSession, question *domain.GameQuestion) int {
+	levelID := session.LevelID
+	if levelID == nil && question.SourceSenseID != nil {
+		senses, err := h.senseRepo.FindSensesByWordID(ctx, question.SourceWordID)
+		if err != nil {
+			h.logger.Warn("failed to find senses for answer difficulty",
+				logger.Error(err),
+				logger.Int64("word_id", question.SourceWordID),
+			)
+			return 1
+		}
+		for _, sense := range senses {
+			if sense.ID == *question.SourceSenseID {
+				levelID = sense.LevelID
+				break
+			}
+		}
+	}
+	if levelID == nil {
+		return 1
+	}
+
+	level, err := h.levelRepo.FindLevelByID(ctx, *levelID)
+	if err != nil {
+		h.logger.Warn("failed to find level for answer difficulty",
+			logger.Error(err),
+			logger.Int64("level_id", *levelID),
+		)
+		return 1
+	}
+	if level == nil || level.DifficultyOrder == nil {
+		return 1
+	}
+	return int(*level.DifficultyOrder)
+}
+
+// checkAnswerDeadline rejects an answer to a question of a timed session whose time is up,
+// see domain.GameSession.OpenQuestion. Questions not shown yet can be answered ahead.
+func (h *Handler) checkAnswerDeadline(ctx context.Context, session *domain.GameSession, question *domain.GameQuestion, answers []*domain.GameAnswer, userID int64) error {
+	if session.QuestionTimeLimitMs == nil {
+		return nil
+	}
+
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, session.ID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	open, _ := session.OpenQuestion(questions, answers, time.Now())
+	if open != nil && question.QuestionOrder >= open.QuestionOrder {
+		return nil
+	}
+
+	h.logger.Info("answer rejected after time limit",
+		logger.Int64("question_id", question.ID),
+		logger.Int64("session_id", session.ID),
+		logger.Int64("user_id", userID),
+	)
+	return sharederrors.MapDomainErrorToAppError(domain.ErrAnswerTimeExpired)
+}
+
+// publishAnswerRecorded notifies the watchers of the user's progress of a stored answer.
+// The answer is already stored, so failures are logged only.
+func (h *Handler) publishAnswerRecorded(ctx context.Context, session *domain.GameSession, question *domain.GameQuestion, answer *domain.GameAnswer) {
+	event, err := eventbus.NewEvent(domain.ProgressTopic(session.UserID), domain.ProgressEventAnswerRecorded, domain.AnswerRecordedEvent{
+		SessionID:        session.ID,
+		UserID:           session.UserID,
+		QuestionID:       question.ID,
+		QuestionOrder:    question.QuestionOrder,
+		IsCorrect:        answer.IsCorrect,
+		CorrectQuestions: session.CorrectQuestions,
+		TotalQuestions:   session.TotalQuestions,
 		AnsweredAt:       answer.AnsweredAt,
+	})
+	if err == nil {
+		err = h.eventBus.Publish(ctx, event)
+	}
+	if err != nil {
+		h.logger.Error("failed to publish answer recorded event",
+			logger.Error(err),
+			logger.Int64("answer_id", answer.ID),
+		)
+	}
+}
+
+// typedAnswerGrade is the grading of a typed answer
+type typedAnswerGrade struct {
+	MatchResult    string
+	ExpectedAnswer string
+}
+
+// gradeTypedAnswer grades a typed answer against every translation of the tested sense,
+// or of the source word for questions not tied to a sense.
+// The expected answer is the translation that matched, or the question's correct word if none did.
+func (h *Handler) gradeTypedAnswer(ctx context.Context, question *domain.GameQuestion, answerText string) (*typedAnswerGrade, error) {
+	var translations []*dictdomain.Word
+	var err error
+	if question.SourceSenseID != nil {
+		translations, err = h.wordRepo.FindTranslationsForSense(ctx, *question.SourceSenseID, question.TargetLanguageID, 50)
+	} else {
+		translations, err = h.wordRepo.FindTranslationsForWord(ctx, question.SourceWordID, question.TargetLanguageID, 50)
+	}
+	if err != nil {
+		h.logger.Error("failed to find translations for typed answer",
+			logger.Error(err),
+			logger.Int64("question_id", question.ID),
+			logger.Int64("word_id", question.SourceWordID),
+		)
+		return nil, err
+	}
+
+	accepted := make([]domain.AcceptedAnswer, 0, len(translations)+1)
+	expectedAnswer := ""
+	for _, trans := range translations {
+		accepted = append(accepted, acceptedAnswerOf(trans))
+		if trans.ID == question.CorrectTargetWordID {
+			expectedAnswer = trans.Lemma
+		}
+	}
+	if expectedAnswer == "" {
+		// The correct word is always accepted, even if it is no longer among the translations
+		correctWord, err := h.wordRepo.FindWordByID(ctx, question.CorrectTargetWordID)
+		if err != nil {
+			h.logger.Error("failed to find correct word for typed answer",
+				logger.Error(err),
+				logger.Int64("question_id", question.ID),
+				logger.Int64("word_id", question.CorrectTargetWordID),
+			)
+			return nil, err
+		}
+		accepted = append(accepted, acceptedAnswerOf(correctWord))
+		expectedAnswer = correctWord.Lemma
+	}
+
+	matchResult, matched := domain.GradeTypedAnswer(answerText, accepted)
+	if matched != nil {
+		expectedAnswer = matched.Lemma
+	}
+
+	return &typedAnswerGrade{
+		MatchResult:    matchResult,
+		ExpectedAnswer: expectedAnswer,
 	}, nil
 }
+
+// acceptedAnswerOf maps a dictionary word to an accepted answer with its normalized spellings
+func acceptedAnswerOf(word *dictdomain.Word) domain.AcceptedAnswer {
+	forms := make([]string, 0, 2)
+	if word.LemmaNormalized != nil {
+		forms = append(forms, *word.LemmaNormalized)
+	}
+	if word.SearchKey != nil {
+		forms = append(forms, *word.SearchKey)
+	}
+	return domain.AcceptedAnswer{
+		WordID: word.ID,
+		Lemma:  word.Lemma,
+		Forms:  forms,
+	}
+}
+
+// completeIfLastQuestion ends the session when every question has an answer.
+// It reports whether this call ended the session.
+func (h *Handler) completeIfLastQuestion(ctx context.Context, session *domain.GameSession) (*gamecompletesession.CompleteSessionOutput, bool, error) {
+	answeredCount, err := h.answerRepo.CountGameAnswersBySessionID(ctx, session.ID, session.UserID)
+	if err != nil {
+		h.logger.Error("failed to count session answers",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, false, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if answeredCount < int64(session.TotalQuestions) {
+		return nil, false, nil
+	}
+
+	return h.completeSessionUC.EndSession(ctx, session)
+}
@@ -1,9 +1,35 @@
 package submit_answer
 
+import (
+	"errors"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+)
+
 // SubmitAnswerInput represents the input to submit an answer use case.
 type SubmitAnswerInput struct {
 	QuestionID       int64
-	SelectedOptionID int64
-	ResponseTimeMs   *int
+	SelectedOptionID *int64  // Required for multiple-choice questions
+	AnswerText       *string // Required for typed questions
+	ResponseTimeMs   *int    // Optional, measured by the client; never negative
 }
 
+// Validate validates the SubmitAnswerInput against the type of the answered question.
+func (r *SubmitAnswerInput) Validate(questionType string) error {
+	if r.ResponseTimeMs != nil && *r.ResponseTimeMs < 0 {
+		return errors.New("Response_time_ms không được âm")
+	}
+
+	if domain.IsTypedAnswer(questionType) {
+		if r.AnswerText == nil || strings.TrimSpace(*r.AnswerText) == "" {
+			return errors.New("Answer_text là bắt buộc với câu hỏi nhập đáp án")
+		}
+		return nil
+	}
+
+	if r.SelectedOptionID == nil || *r.SelectedOptionID <= 0 {
+		return errors.New("Selected_option_id là bắt buộc với câu hỏi trắc nghiệm")
+	}
+	return nil
+}
@@ -1,6 +1,11 @@
 package submit_answer
 
-import "time"
+import (
+	"time"
+
+	statsawardachievements "github.com/english-coach/backend/internal/modules/statistics/usecase/award_achievements"
+	gamecompletesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/complete_session"
+)
 
 // SubmitAnswerOutput represents the output for submitting an answer use case.
 type SubmitAnswerOutput struct {
@@ -11,6 +16,13 @@ type SubmitAnswerOutput struct {
 	SelectedOptionID *int64
 	IsCorrect        bool
 	ResponseTimeMs   *int
+	AnswerText       *string
+	MatchResult      *string // Typed questions only: 'correct', 'almost' or 'wrong'
+	ExpectedAnswer   *string // Typed questions only: the accepted answer closest to the typed text
 	AnsweredAt       time.Time
+	SessionCompleted bool
+	Summary          *gamecompletesession.CompleteSessionOutput
+	NextReviewAt     *time.Time
+	XPEarned         int                                        // Experience points earned by the answer
+	NewAchievements  []statsawardachievements.EarnedAchievement // Achievements earned by the answer
 }
-
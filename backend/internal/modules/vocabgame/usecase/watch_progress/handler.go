@@ -0,0 +1,94 @@
+package watch_progress
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/eventbus"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler subscribes to the live session progress of users
+type Handler struct {
+	progressRepo domain.ProgressRepository
+	eventBus     eventbus.Bus
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	progressRepo domain.ProgressRepository,
+	eventBus eventbus.Bus,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		progressRepo: progressRepo,
+		eventBus:     eventBus,
+		logger:       logger,
+	}
+}
+
+// Execute subscribes to the answers recorded and the sessions ended by the users until ctx is done.
+// Users can always watch themselves; other users must have opted in to share their progress.
+func (h *Handler) Execute(ctx context.Context, input WatchProgressInput) (*WatchProgressOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
+	}
+
+	userIDs := uniqueUserIDs(input.UserIDs)
+	if len(userIDs) == 0 {
+		userIDs = []int64{input.ViewerID}
+	}
+
+	topics := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID != input.ViewerID {
+			shared, err := h.progressRepo.IsProgressShared(ctx, userID)
+			if err != nil {
+				h.logger.Error("failed to check progress sharing",
+					logger.Error(err),
+					logger.Int64("user_id", userID),
+				)
+				return nil, sharederrors.MapDomainErrorToAppError(err)
+			}
+			if !shared {
+				return nil, sharederrors.MapDomainErrorToAppError(domain.ErrProgressNotShared)
+			}
+		}
+		topics = append(topics, domain.ProgressTopic(userID))
+	}
+
+	events, err := h.eventBus.Subscribe(ctx, topics...)
+	if err != nil {
+		h.logger.Error("failed to subscribe to progress events",
+			logger.Error(err),
+			logger.Int64("viewer_id", input.ViewerID),
+		)
+		return nil, sharederrors.ErrInternalError
+	}
+
+	h.logger.Info("vocabgame progress watched",
+		logger.Int64("viewer_id", input.ViewerID),
+		logger.Int("user_count", len(userIDs)),
+	)
+
+	return &WatchProgressOutput{
+		UserIDs: userIDs,
+		Events:  events,
+	}, nil
+}
+
+// uniqueUserIDs returns the user IDs without duplicates, keeping their order
+func uniqueUserIDs(userIDs []int64) []int64 {
+	seen := make(map[int64]struct{}, len(userIDs))
+	unique := make([]int64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		unique = append(unique, userID)
+	}
+	return unique
+}
@@ -0,0 +1,26 @@
+package watch_progress
+
+import (
+	"errors"
+
+	"github.com/english-coach/backend/internal/shared/constants"
+)
+
+// WatchProgressInput represents the input to watch the live progress of users use case.
+type WatchProgressInput struct {
+	ViewerID int64
+	UserIDs  []int64 // Users whose progress is watched, empty means the viewer
+}
+
+// Validate validates the WatchProgressInput.
+func (r *WatchProgressInput) Validate() error {
+	if len(r.UserIDs) > constants.MaxWatchedProgressUsers {
+		return errors.New("Chỉ có thể theo dõi tối đa 50 người dùng cùng lúc")
+	}
+	for _, userID := range r.UserIDs {
+		if userID <= 0 {
+			return errors.New("ID người dùng phải lớn hơn 0")
+		}
+	}
+	return nil
+}
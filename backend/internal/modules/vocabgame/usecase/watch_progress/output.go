@@ -0,0 +1,11 @@
+package watch_progress
+
+import "github.com/english-coach/backend/internal/shared/eventbus"
+
+// WatchProgressOutput represents the output for watching the live progress of users use case.
+type WatchProgressOutput struct {
+	UserIDs []int64
+	// Events receives the progress events of the watched users until the watch context is done,
+	// then it is closed
+	Events <-chan eventbus.Event
+}
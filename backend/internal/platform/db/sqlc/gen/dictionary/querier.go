@@ -11,11 +11,31 @@ import (
 )
 
 type Querier interface {
+	CountListWords(ctx context.Context, arg CountListWordsParams) (int64, error)
+	CountReverseSearchWords(ctx context.Context, arg CountReverseSearchWordsParams) (int64, error)
 	CountSearchWords(ctx context.Context, arg CountSearchWordsParams) (int64, error)
+	// Facet of SearchWords: the number of matching words per level of their senses, whatever the level
+	// filter so that the other levels can be picked. A word with senses of several levels counts in each;
+	// with a part of speech filter only the senses of that part of speech count.
+	CountSearchWordsByLevel(ctx context.Context, arg CountSearchWordsByLevelParams) ([]CountSearchWordsByLevelRow, error)
+	// Facet of SearchWords: the number of matching words per topic, whatever the topic filter so that
+	// other topics can be picked. The largest topics come first.
+	CountSearchWordsByTopic(ctx context.Context, arg CountSearchWordsByTopicParams) ([]CountSearchWordsByTopicRow, error)
 	FindAllLanguages(ctx context.Context) ([]Language, error)
 	FindAllLevels(ctx context.Context) ([]Level, error)
 	FindAllPartsOfSpeech(ctx context.Context) ([]PartsOfSpeech, error)
 	FindAllTopics(ctx context.Context) ([]Topic, error)
+	// Every word of every language, the source of the in-memory autocomplete index
+	FindAllWords(ctx context.Context) ([]Word, error)
+	// Candidate wrong answers for a word: words of the same language ranked by a shared part of
+	// speech and a shared topic, then by the closest frequency rank. Only a pool of words found
+	// through indexes is ranked: the closest frequency ranks on both sides of the word, unranked
+	// words and words sharing a topic with it, each taken in a fixed order so that seeded sessions
+	// get the same pool. The word itself, words with the same lemma, synonyms
+	// of the word, the excluded words and words translated into one of the excluded translations
+	// are left out.
+	FindDistractorWords(ctx context.Context, arg FindDistractorWordsParams) ([]Word, error)
+	FindExamplesBySenseIDs(ctx context.Context, dollar_1 []int64) ([]Example, error)
 	FindLanguageByCode(ctx context.Context, code string) (Language, error)
 	FindLanguageByID(ctx context.Context, id int16) (Language, error)
 	FindLevelByCode(ctx context.Context, code string) (Level, error)
@@ -24,17 +44,45 @@ type Querier interface {
 	FindPartOfSpeechByCode(ctx context.Context, code string) (PartsOfSpeech, error)
 	FindPartOfSpeechByID(ctx context.Context, id int16) (PartsOfSpeech, error)
 	FindPartsOfSpeechByIDs(ctx context.Context, dollar_1 []int16) ([]PartsOfSpeech, error)
+	FindPronunciationsByWordIDs(ctx context.Context, dollar_1 []int64) ([]Pronunciation, error)
 	FindSensesByWordID(ctx context.Context, wordID int64) ([]Sense, error)
 	FindSensesByWordIDs(ctx context.Context, dollar_1 []int64) ([]Sense, error)
 	FindTopicByCode(ctx context.Context, code string) (Topic, error)
 	FindTopicByID(ctx context.Context, id int64) (Topic, error)
+	FindTranslationsForSense(ctx context.Context, arg FindTranslationsForSenseParams) ([]Word, error)
 	FindTranslationsForWord(ctx context.Context, arg FindTranslationsForWordParams) ([]Word, error)
 	FindWordByID(ctx context.Context, id int64) (Word, error)
+	// Fingerprint of the words table: adding, removing or updating a word changes it
+	FindWordIndexVersion(ctx context.Context) (FindWordIndexVersionRow, error)
+	// Words of every level in a language pair, optionally filtered by topics.
+	// Words are interleaved by level (the most frequent word of each level first)
+	// so that a limited result spans all levels.
+	FindWordsAcrossLevelsAndLanguages(ctx context.Context, arg FindWordsAcrossLevelsAndLanguagesParams) ([]Word, error)
 	FindWordsByIDs(ctx context.Context, dollar_1 []int64) ([]Word, error)
 	FindWordsByLevelAndLanguages(ctx context.Context, arg FindWordsByLevelAndLanguagesParams) ([]Word, error)
 	FindWordsByLevelAndTopicsAndLanguages(ctx context.Context, arg FindWordsByLevelAndTopicsAndLanguagesParams) ([]Word, error)
 	FindWordsByTopicAndLanguages(ctx context.Context, arg FindWordsByTopicAndLanguagesParams) ([]Word, error)
+	// Words of a language for browsing, optionally filtered by level (of a sense) and topics (any of
+	// them), sorted by frequency or alphabetically by search key (pinyin for Chinese). Each word comes
+	// with its primary translation into the target language, if any: the translation of its first
+	// translated sense with the highest priority.
+	ListWords(ctx context.Context, arg ListWordsParams) ([]ListWordsRow, error)
+	// Words of a language found by their meaning, the reverse of FindTranslationsForWord: words having
+	// a sense translated into a word of the source language whose lemma, normalized form or search key
+	// contains the query, or a sense whose definition contains it. Each word comes once with its best matching sense; exact
+	// translations rank first, then partial translations, then definitions, then senses in order.
+	ReverseSearchWords(ctx context.Context, arg ReverseSearchWordsParams) ([]ReverseSearchWordsRow, error)
+	// Words whose lemma, normalized form or search key contain the query, or are similar enough to it
+	// to catch misspellings (trigram similarity above pg_trgm.similarity_threshold, 0.3 by default).
+	// Every condition is served by the trigram indexes. Exact matches come first, then prefix matches,
+	// then substring matches, then similar words; within each group words are ranked by similarity
+	// boosted for frequent words. The optional filters narrow the words down by topics (any of them),
+	// level and part of speech (of the same sense), audio pronunciation and script.
 	SearchWords(ctx context.Context, arg SearchWordsParams) ([]Word, error)
+	// "Did you mean" lemmas for a query matching no word: the nearest words by trigram distance over
+	// the lemma, the normalized form and the search key, each found by a GiST index scan, keeping those
+	// at least min_similarity similar to the query
+	SuggestWords(ctx context.Context, arg SuggestWordsParams) ([]string, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -11,29 +11,585 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countListWords = `-- name: CountListWords :one
+SELECT COUNT(*)
+FROM words w
+WHERE w.language_id = $1
+  AND (
+    $2::bigint IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND s.level_id = $2
+    )
+  )
+  AND (
+    $3::bigint[] IS NULL
+    OR array_length($3::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY($3::bigint[])
+    )
+  )
+`
+
+type CountListWordsParams struct {
+	LanguageID int16       `json:"language_id"`
+	LevelID    pgtype.Int8 `json:"level_id"`
+	TopicIds   []int64     `json:"topic_ids"`
+}
+
+func (q *Queries) CountListWords(ctx context.Context, arg CountListWordsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countListWords, arg.LanguageID, arg.LevelID, arg.TopicIds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countReverseSearchWords = `-- name: CountReverseSearchWords :one
+WITH matched_senses AS (
+  SELECT st.source_sense_id AS sense_id
+  FROM words tw
+  INNER JOIN sense_translations st ON st.target_word_id = tw.id
+  WHERE (tw.lemma ILIKE $1
+      OR tw.lemma_normalized ILIKE $1
+      OR tw.search_key ILIKE $1)
+    AND tw.language_id = $2
+  UNION
+  SELECT s.id
+  FROM senses s
+  WHERE s.definition ILIKE $1
+)
+SELECT COUNT(DISTINCT s.word_id)
+FROM matched_senses m
+INNER JOIN senses s ON s.id = m.sense_id
+INNER JOIN words w ON w.id = s.word_id
+WHERE w.language_id = $3
+`
+
+type CountReverseSearchWordsParams struct {
+	SearchPattern    string `json:"search_pattern"`
+	SourceLanguageID int16  `json:"source_language_id"`
+	LanguageID       int16  `json:"language_id"`
+}
+
+func (q *Queries) CountReverseSearchWords(ctx context.Context, arg CountReverseSearchWordsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countReverseSearchWords, arg.SearchPattern, arg.SourceLanguageID, arg.LanguageID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countSearchWords = `-- name: CountSearchWords :one
-SELECT COUNT(DISTINCT w.id)
+SELECT COUNT(*)
 FROM words w
 WHERE w.language_id = $1
   AND (
     w.lemma ILIKE $2
     OR w.lemma_normalized ILIKE $2
     OR w.search_key ILIKE $2
+    OR w.lemma % $3::text
+    OR w.lemma_normalized % $3::text
+    OR w.search_key % $3::text
+  )
+  AND (
+    $4::bigint[] IS NULL
+    OR array_length($4::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY($4::bigint[])
+    )
+  )
+  AND (
+    -- Level and part of speech must be those of the same sense
+    ($5::bigint IS NULL AND $6::smallint IS NULL)
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND ($5::bigint IS NULL OR s.level_id = $5)
+        AND ($6::smallint IS NULL OR s.part_of_speech_id = $6)
+    )
+  )
+  AND (
+    $7::boolean IS NULL
+    OR $7::boolean = EXISTS (
+      SELECT 1
+      FROM pronunciations p
+      WHERE p.word_id = w.id
+        AND p.audio_url IS NOT NULL
+        AND p.audio_url <> ''
+    )
   )
+  AND ($8::text IS NULL OR w.script_code = $8)
 `
 
 type CountSearchWordsParams struct {
-	LanguageID    int16  `json:"language_id"`
-	SearchPattern string `json:"search_pattern"`
+	LanguageID     int16       `json:"language_id"`
+	SearchPattern  string      `json:"search_pattern"`
+	Query          string      `json:"query"`
+	TopicIds       []int64     `json:"topic_ids"`
+	LevelID        pgtype.Int8 `json:"level_id"`
+	PartOfSpeechID pgtype.Int2 `json:"part_of_speech_id"`
+	HasAudio       pgtype.Bool `json:"has_audio"`
+	ScriptCode     pgtype.Text `json:"script_code"`
 }
 
 func (q *Queries) CountSearchWords(ctx context.Context, arg CountSearchWordsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSearchWords, arg.LanguageID, arg.SearchPattern)
+	row := q.db.QueryRow(ctx, countSearchWords,
+		arg.LanguageID,
+		arg.SearchPattern,
+		arg.Query,
+		arg.TopicIds,
+		arg.LevelID,
+		arg.PartOfSpeechID,
+		arg.HasAudio,
+		arg.ScriptCode,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countSearchWordsByLevel = `-- name: CountSearchWordsByLevel :many
+WITH matches AS (
+  SELECT w.id
+  FROM words w
+  WHERE w.language_id = $1
+    AND (
+      w.lemma ILIKE $2
+      OR w.lemma_normalized ILIKE $2
+      OR w.search_key ILIKE $2
+      OR w.lemma % $3::text
+      OR w.lemma_normalized % $3::text
+      OR w.search_key % $3::text
+    )
+    AND (
+      $4::bigint[] IS NULL
+      OR array_length($4::bigint[], 1) IS NULL
+      OR EXISTS (
+        SELECT 1
+        FROM word_topics wt
+        WHERE wt.word_id = w.id
+          AND wt.topic_id = ANY($4::bigint[])
+      )
+    )
+    AND (
+      $5::boolean IS NULL
+      OR $5::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND ($6::text IS NULL OR w.script_code = $6)
+)
+SELECT l.id, l.code, l.name, COUNT(DISTINCT s.word_id) AS word_count
+FROM matches m
+INNER JOIN senses s ON s.word_id = m.id
+INNER JOIN levels l ON l.id = s.level_id
+WHERE $7::smallint IS NULL OR s.part_of_speech_id = $7
+GROUP BY l.id, l.code, l.name, l.difficulty_order
+ORDER BY l.difficulty_order NULLS LAST, l.id
+`
+
+type CountSearchWordsByLevelParams struct {
+	LanguageID     int16       `json:"language_id"`
+	SearchPattern  string      `json:"search_pattern"`
+	Query          string      `json:"query"`
+	TopicIds       []int64     `json:"topic_ids"`
+	HasAudio       pgtype.Bool `json:"has_audio"`
+	ScriptCode     pgtype.Text `json:"script_code"`
+	PartOfSpeechID pgtype.Int2 `json:"part_of_speech_id"`
+}
+
+type CountSearchWordsByLevelRow struct {
+	ID        int64  `json:"id"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	WordCount int64  `json:"word_count"`
+}
+
+// Facet of SearchWords: the number of matching words per level of their senses, whatever the level
+// filter so that the other levels can be picked. A word with senses of several levels counts in each;
+// with a part of speech filter only the senses of that part of speech count.
+func (q *Queries) CountSearchWordsByLevel(ctx context.Context, arg CountSearchWordsByLevelParams) ([]CountSearchWordsByLevelRow, error) {
+	rows, err := q.db.Query(ctx, countSearchWordsByLevel,
+		arg.LanguageID,
+		arg.SearchPattern,
+		arg.Query,
+		arg.TopicIds,
+		arg.HasAudio,
+		arg.ScriptCode,
+		arg.PartOfSpeechID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountSearchWordsByLevelRow{}
+	for rows.Next() {
+		var i CountSearchWordsByLevelRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.WordCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countSearchWordsByTopic = `-- name: CountSearchWordsByTopic :many
+WITH matches AS (
+  SELECT w.id
+  FROM words w
+  WHERE w.language_id = $1
+    AND (
+      w.lemma ILIKE $2
+      OR w.lemma_normalized ILIKE $2
+      OR w.search_key ILIKE $2
+      OR w.lemma % $3::text
+      OR w.lemma_normalized % $3::text
+      OR w.search_key % $3::text
+    )
+    AND (
+      -- Level and part of speech must be those of the same sense
+      ($4::bigint IS NULL AND $5::smallint IS NULL)
+      OR EXISTS (
+        SELECT 1
+        FROM senses s
+        WHERE s.word_id = w.id
+          AND ($4::bigint IS NULL OR s.level_id = $4)
+          AND ($5::smallint IS NULL OR s.part_of_speech_id = $5)
+      )
+    )
+    AND (
+      $6::boolean IS NULL
+      OR $6::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND ($7::text IS NULL OR w.script_code = $7)
+)
+SELECT t.id, t.code, t.name, COUNT(*) AS word_count
+FROM matches m
+INNER JOIN word_topics wt ON wt.word_id = m.id
+INNER JOIN topics t ON t.id = wt.topic_id
+GROUP BY t.id, t.code, t.name
+ORDER BY word_count DESC, t.id
+`
+
+type CountSearchWordsByTopicParams struct {
+	LanguageID     int16       `json:"language_id"`
+	SearchPattern  string      `json:"search_pattern"`
+	Query          string      `json:"query"`
+	LevelID        pgtype.Int8 `json:"level_id"`
+	PartOfSpeechID pgtype.Int2 `json:"part_of_speech_id"`
+	HasAudio       pgtype.Bool `json:"has_audio"`
+	ScriptCode     pgtype.Text `json:"script_code"`
+}
+
+type CountSearchWordsByTopicRow struct {
+	ID        int64  `json:"id"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	WordCount int64  `json:"word_count"`
+}
+
+// Facet of SearchWords: the number of matching words per topic, whatever the topic filter so that
+// other topics can be picked. The largest topics come first.
+func (q *Queries) CountSearchWordsByTopic(ctx context.Context, arg CountSearchWordsByTopicParams) ([]CountSearchWordsByTopicRow, error) {
+	rows, err := q.db.Query(ctx, countSearchWordsByTopic,
+		arg.LanguageID,
+		arg.SearchPattern,
+		arg.Query,
+		arg.LevelID,
+		arg.PartOfSpeechID,
+		arg.HasAudio,
+		arg.ScriptCode,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountSearchWordsByTopicRow{}
+	for rows.Next() {
+		var i CountSearchWordsByTopicRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.WordCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findAllWords = `-- name: FindAllWords :many
+SELECT id, language_id, lemma, lemma_normalized, search_key,
+       romanization, script_code, frequency_rank,
+       note, created_at, updated_at
+FROM words
+ORDER BY id
+`
+
+// Every word of every language, the source of the in-memory autocomplete index
+func (q *Queries) FindAllWords(ctx context.Context) ([]Word, error) {
+	rows, err := q.db.Query(ctx, findAllWords)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Word{}
+	for rows.Next() {
+		var i Word
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findDistractorWords = `-- name: FindDistractorWords :many
+WITH target AS (
+  SELECT id, language_id, lemma, frequency_rank
+  FROM words
+  WHERE id = $1
+),
+pool AS (
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank >= t.frequency_rank
+    ORDER BY w.frequency_rank, w.id
+    LIMIT $2::int * 4
+  )
+  UNION
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank < t.frequency_rank
+    ORDER BY w.frequency_rank DESC, w.id
+    LIMIT $2::int * 4
+  )
+  UNION
+  (
+    SELECT w.id
+    FROM words w
+    JOIN target t ON w.language_id = t.language_id
+    WHERE w.frequency_rank IS NULL
+    ORDER BY w.id
+    LIMIT $2::int * 4
+  )
+  UNION
+  (
+    SELECT wt.word_id
+    FROM word_topics twt
+    JOIN word_topics wt ON wt.topic_id = twt.topic_id
+    WHERE twt.word_id = $1
+    ORDER BY wt.word_id
+    LIMIT $2::int * 4
+  )
+),
+synonyms AS (
+  SELECT wr.to_word_id AS word_id
+  FROM word_relations wr
+  WHERE wr.from_word_id = $1 AND wr.relation_type = 'synonym'
+  UNION
+  SELECT wr.from_word_id
+  FROM word_relations wr
+  WHERE wr.to_word_id = $1 AND wr.relation_type = 'synonym'
+),
+candidates AS (
+  SELECT
+    w.id,
+    EXISTS (
+      SELECT 1
+      FROM senses s
+      JOIN senses ts ON ts.part_of_speech_id = s.part_of_speech_id
+      WHERE s.word_id = w.id AND ts.word_id = t.id
+    ) AS same_pos,
+    EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      JOIN word_topics twt ON twt.topic_id = wt.topic_id
+      WHERE wt.word_id = w.id AND twt.word_id = t.id
+    ) AS same_topic,
+    ABS(COALESCE(w.frequency_rank, 1000000) - COALESCE(t.frequency_rank, 1000000)) AS frequency_gap
+  FROM pool p
+  JOIN words w ON w.id = p.id
+  CROSS JOIN target t
+  WHERE w.language_id = t.language_id
+    AND w.id <> t.id
+    AND LOWER(w.lemma) <> LOWER(t.lemma)
+    AND NOT (w.id = ANY($3::bigint[]))
+    AND w.id NOT IN (SELECT word_id FROM synonyms)
+    AND NOT EXISTS (
+      SELECT 1
+      FROM senses s
+      JOIN sense_translations st ON st.source_sense_id = s.id
+      WHERE s.word_id = w.id
+        AND st.target_word_id = ANY($4::bigint[])
+    )
+)
+SELECT
+  w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+  w.romanization, w.script_code, w.frequency_rank,
+  w.note, w.created_at, w.updated_at
+FROM candidates c
+JOIN words w ON w.id = c.id
+ORDER BY (c.same_pos::int + c.same_topic::int) DESC,
+         c.frequency_gap,
+         w.id
+LIMIT $2::int
+`
+
+type FindDistractorWordsParams struct {
+	WordID                 int64   `json:"word_id"`
+	Limit                  int32   `json:"limit"`
+	ExcludedIds            []int64 `json:"excluded_ids"`
+	ExcludedTranslationIds []int64 `json:"excluded_translation_ids"`
+}
+
+// Candidate wrong answers for a word: words of the same language ranked by a shared part of
+// speech and a shared topic, then by the closest frequency rank. Only a pool of words found
+// through indexes is ranked: the closest frequency ranks on both sides of the word, unranked
+// words and words sharing a topic with it, each taken in a fixed order so that seeded sessions
+// get the same pool. The word itself, words with the same lemma, synonyms
+// of the word, the excluded words and words translated into one of the excluded translations
+// are left out.
+func (q *Queries) FindDistractorWords(ctx context.Context, arg FindDistractorWordsParams) ([]Word, error) {
+	rows, err := q.db.Query(ctx, findDistractorWords,
+		arg.WordID,
+		arg.Limit,
+		arg.ExcludedIds,
+		arg.ExcludedTranslationIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Word{}
+	for rows.Next() {
+		var i Word
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findTranslationsForSense = `-- name: FindTranslationsForSense :many
+SELECT
+  tw.id, tw.language_id, tw.lemma, tw.lemma_normalized, tw.search_key,
+  tw.romanization, tw.script_code, tw.frequency_rank,
+  tw.note, tw.created_at, tw.updated_at
+FROM sense_translations st
+JOIN words tw ON tw.id = st.target_word_id
+WHERE st.source_sense_id = $1
+  AND tw.language_id = $2
+ORDER BY st.priority ASC NULLS LAST,
+         tw.frequency_rank NULLS LAST,
+         tw.id
+LIMIT $3
+`
+
+type FindTranslationsForSenseParams struct {
+	SourceSenseID    int64 `json:"source_sense_id"`
+	TargetLanguageID int16 `json:"target_language_id"`
+	Limit            int32 `json:"limit"`
+}
+
+func (q *Queries) FindTranslationsForSense(ctx context.Context, arg FindTranslationsForSenseParams) ([]Word, error) {
+	rows, err := q.db.Query(ctx, findTranslationsForSense, arg.SourceSenseID, arg.TargetLanguageID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Word{}
+	for rows.Next() {
+		var i Word
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findTranslationsForWord = `-- name: FindTranslationsForWord :many
 WITH ranked AS (
   SELECT
@@ -122,6 +678,123 @@ func (q *Queries) FindWordByID(ctx context.Context, id int64) (Word, error) {
 	return i, err
 }
 
+const findWordIndexVersion = `-- name: FindWordIndexVersion :one
+SELECT COUNT(*) AS word_count,
+       COALESCE(MAX(id), 0)::bigint AS max_word_id,
+       COALESCE(MAX(updated_at), 'epoch'::timestamp)::timestamp AS last_updated_at
+FROM words
+`
+
+type FindWordIndexVersionRow struct {
+	WordCount     int64            `json:"word_count"`
+	MaxWordID     int64            `json:"max_word_id"`
+	LastUpdatedAt pgtype.Timestamp `json:"last_updated_at"`
+}
+
+// Fingerprint of the words table: adding, removing or updating a word changes it
+func (q *Queries) FindWordIndexVersion(ctx context.Context) (FindWordIndexVersionRow, error) {
+	row := q.db.QueryRow(ctx, findWordIndexVersion)
+	var i FindWordIndexVersionRow
+	err := row.Scan(
+		&i.WordCount,
+		&i.MaxWordID,
+		&i.LastUpdatedAt,
+	)
+	return i, err
+}
+
+const findWordsAcrossLevelsAndLanguages = `-- name: FindWordsAcrossLevelsAndLanguages :many
+WITH word_levels AS (
+    -- A word takes the lowest level among its senses that can be translated
+    SELECT w.id, MIN(s.level_id) AS level_id
+    FROM words w
+    INNER JOIN senses s ON w.id = s.word_id
+    WHERE w.language_id = $1
+      AND s.level_id IS NOT NULL
+      AND (
+        $2::bigint[] IS NULL
+        OR array_length($2::bigint[], 1) IS NULL
+        OR EXISTS (
+          SELECT 1
+          FROM word_topics wt
+          WHERE wt.word_id = w.id
+            AND wt.topic_id = ANY($2::bigint[])
+        )
+      )
+      AND EXISTS (
+          SELECT 1
+          FROM sense_translations st
+          INNER JOIN words tw ON st.target_word_id = tw.id
+          WHERE st.source_sense_id = s.id
+            AND tw.language_id = $3
+      )
+    GROUP BY w.id
+),
+ranked AS (
+    SELECT wl.id,
+           ROW_NUMBER() OVER (
+               PARTITION BY wl.level_id
+               ORDER BY w.frequency_rank NULLS LAST, w.id
+           ) AS level_rank
+    FROM word_levels wl
+    INNER JOIN words w ON w.id = wl.id
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at
+FROM ranked r
+INNER JOIN words w ON w.id = r.id
+ORDER BY r.level_rank, w.frequency_rank NULLS LAST, w.id
+LIMIT $4
+`
+
+type FindWordsAcrossLevelsAndLanguagesParams struct {
+	SourceLanguageID int16   `json:"source_language_id"`
+	TopicIds         []int64 `json:"topic_ids"`
+	TargetLanguageID int16   `json:"target_language_id"`
+	Limit            int32   `json:"limit"`
+}
+
+// Words of every level in a language pair, optionally filtered by topics.
+// Words are interleaved by level (the most frequent word of each level first)
+// so that a limited result spans all levels.
+func (q *Queries) FindWordsAcrossLevelsAndLanguages(ctx context.Context, arg FindWordsAcrossLevelsAndLanguagesParams) ([]Word, error) {
+	rows, err := q.db.Query(ctx, findWordsAcrossLevelsAndLanguages,
+		arg.SourceLanguageID,
+		arg.TopicIds,
+		arg.TargetLanguageID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Word{}
+	for rows.Next() {
+		var i Word
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findWordsByIDs = `-- name: FindWordsByIDs :many
 SELECT id, language_id, lemma, lemma_normalized, search_key,
        romanization, script_code, frequency_rank,
@@ -367,43 +1040,351 @@ func (q *Queries) FindWordsByTopicAndLanguages(ctx context.Context, arg FindWord
 	return items, nil
 }
 
-const searchWords = `-- name: SearchWords :many
+const listWords = `-- name: ListWords :many
 SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
        w.romanization, w.script_code, w.frequency_rank,
-       w.note, w.created_at, w.updated_at
+       w.note, w.created_at, w.updated_at,
+       tr.id AS translation_id, tr.lemma AS translation_lemma, tr.romanization AS translation_romanization
 FROM words w
-WHERE w.language_id = $1
+LEFT JOIN LATERAL (
+  SELECT tw.id, tw.lemma, tw.romanization
+  FROM senses s
+  INNER JOIN sense_translations st ON st.source_sense_id = s.id
+  INNER JOIN words tw ON tw.id = st.target_word_id
+  WHERE s.word_id = w.id
+    AND tw.language_id = $1
+  ORDER BY s.sense_order, st.priority NULLS LAST, tw.frequency_rank NULLS LAST, tw.id
+  LIMIT 1
+) tr ON TRUE
+WHERE w.language_id = $2
   AND (
-    w.lemma ILIKE $2
-    OR w.lemma_normalized ILIKE $2
-    OR w.search_key ILIKE $2
+    $3::bigint IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM senses s
+      WHERE s.word_id = w.id
+        AND s.level_id = $3
+    )
+  )
+  AND (
+    $4::bigint[] IS NULL
+    OR array_length($4::bigint[], 1) IS NULL
+    OR EXISTS (
+      SELECT 1
+      FROM word_topics wt
+      WHERE wt.word_id = w.id
+        AND wt.topic_id = ANY($4::bigint[])
+    )
   )
-ORDER BY 
-  CASE 
-    WHEN w.lemma = $3 THEN 1
-    WHEN w.lemma ILIKE $2 THEN 2
-    WHEN w.lemma_normalized ILIKE $2 THEN 3
-    WHEN w.search_key ILIKE $2 THEN 4
-    ELSE 5
-  END,
-  w.frequency_rank NULLS LAST,
-  w.id
-LIMIT $5 OFFSET $4
+ORDER BY
+  CASE WHEN $5::boolean THEN w.frequency_rank END NULLS LAST,
+  COALESCE(w.search_key, w.lemma_normalized, LOWER(w.lemma)), w.lemma, w.id
+LIMIT $7 OFFSET $6
+`
+
+type ListWordsParams struct {
+	TargetLanguageID pgtype.Int2 `json:"target_language_id"`
+	LanguageID       int16       `json:"language_id"`
+	LevelID          pgtype.Int8 `json:"level_id"`
+	TopicIds         []int64     `json:"topic_ids"`
+	SortByFrequency  bool        `json:"sort_by_frequency"`
+	Offset           int32       `json:"offset"`
+	Limit            int32       `json:"limit"`
+}
+
+type ListWordsRow struct {
+	ID                      int64            `json:"id"`
+	LanguageID              int16            `json:"language_id"`
+	Lemma                   string           `json:"lemma"`
+	LemmaNormalized         pgtype.Text      `json:"lemma_normalized"`
+	SearchKey               pgtype.Text      `json:"search_key"`
+	Romanization            pgtype.Text      `json:"romanization"`
+	ScriptCode              pgtype.Text      `json:"script_code"`
+	FrequencyRank           pgtype.Int4      `json:"frequency_rank"`
+	Note                    pgtype.Text      `json:"note"`
+	CreatedAt               pgtype.Timestamp `json:"created_at"`
+	UpdatedAt               pgtype.Timestamp `json:"updated_at"`
+	TranslationID           pgtype.Int8      `json:"translation_id"`
+	TranslationLemma        pgtype.Text      `json:"translation_lemma"`
+	TranslationRomanization pgtype.Text      `json:"translation_romanization"`
+}
+
+// Words of a language for browsing, optionally filtered by level (of a sense) and topics (any of
+// them), sorted by frequency or alphabetically by search key (pinyin for Chinese). Each word comes
+// with its primary translation into the target language, if any: the translation of its first
+// translated sense with the highest priority.
+func (q *Queries) ListWords(ctx context.Context, arg ListWordsParams) ([]ListWordsRow, error) {
+	rows, err := q.db.Query(ctx, listWords,
+		arg.TargetLanguageID,
+		arg.LanguageID,
+		arg.LevelID,
+		arg.TopicIds,
+		arg.SortByFrequency,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListWordsRow{}
+	for rows.Next() {
+		var i ListWordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TranslationID,
+			&i.TranslationLemma,
+			&i.TranslationRomanization,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reverseSearchWords = `-- name: ReverseSearchWords :many
+WITH sense_matches AS (
+  SELECT s.id AS sense_id,
+         s.word_id,
+         s.sense_order,
+         'translation'::text AS matched_by,
+         tw.lemma::text AS matched_text,
+         CASE
+           WHEN LOWER(tw.lemma) = LOWER($1::text)
+             OR tw.lemma_normalized = LOWER($1::text)
+             OR tw.search_key = LOWER($1::text) THEN 1
+           ELSE 2
+         END AS match_rank,
+         st.priority
+  FROM words tw
+  INNER JOIN sense_translations st ON st.target_word_id = tw.id
+  INNER JOIN senses s ON s.id = st.source_sense_id
+  WHERE (tw.lemma ILIKE $2
+      OR tw.lemma_normalized ILIKE $2
+      OR tw.search_key ILIKE $2)
+    AND tw.language_id = $3
+  UNION ALL
+  SELECT s.id, s.word_id, s.sense_order, 'definition'::text, s.definition, 3, NULL::smallint
+  FROM senses s
+  WHERE s.definition ILIKE $2
+),
+best AS (
+  SELECT DISTINCT ON (m.word_id)
+         m.word_id, m.sense_id, m.matched_by, m.matched_text, m.match_rank
+  FROM sense_matches m
+  INNER JOIN words w ON w.id = m.word_id
+  WHERE w.language_id = $4
+  ORDER BY m.word_id, m.match_rank, m.sense_order, m.priority NULLS LAST
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       s.id AS sense_id, s.sense_order, s.part_of_speech_id, s.definition, s.definition_language_id,
+       b.matched_by, b.matched_text
+FROM best b
+INNER JOIN words w ON w.id = b.word_id
+INNER JOIN senses s ON s.id = b.sense_id
+ORDER BY b.match_rank, w.frequency_rank NULLS LAST, w.id
+LIMIT $6 OFFSET $5
+`
+
+type ReverseSearchWordsParams struct {
+	Query            string `json:"query"`
+	SearchPattern    string `json:"search_pattern"`
+	SourceLanguageID int16  `json:"source_language_id"`
+	LanguageID       int16  `json:"language_id"`
+	Offset           int32  `json:"offset"`
+	Limit            int32  `json:"limit"`
+}
+
+type ReverseSearchWordsRow struct {
+	ID                   int64            `json:"id"`
+	LanguageID           int16            `json:"language_id"`
+	Lemma                string           `json:"lemma"`
+	LemmaNormalized      pgtype.Text      `json:"lemma_normalized"`
+	SearchKey            pgtype.Text      `json:"search_key"`
+	Romanization         pgtype.Text      `json:"romanization"`
+	ScriptCode           pgtype.Text      `json:"script_code"`
+	FrequencyRank        pgtype.Int4      `json:"frequency_rank"`
+	Note                 pgtype.Text      `json:"note"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
+	SenseID              int64            `json:"sense_id"`
+	SenseOrder           int16            `json:"sense_order"`
+	PartOfSpeechID       int16            `json:"part_of_speech_id"`
+	Definition           string           `json:"definition"`
+	DefinitionLanguageID int16            `json:"definition_language_id"`
+	MatchedBy            string           `json:"matched_by"`
+	MatchedText          string           `json:"matched_text"`
+}
+
+// Words of a language found by their meaning, the reverse of FindTranslationsForWord: words having
+// a sense translated into a word of the source language whose lemma, normalized form or search key
+// contains the query, or a sense whose definition contains it. Each word comes once with its best matching sense; exact
+// translations rank first, then partial translations, then definitions, then senses in order.
+func (q *Queries) ReverseSearchWords(ctx context.Context, arg ReverseSearchWordsParams) ([]ReverseSearchWordsRow, error) {
+	rows, err := q.db.Query(ctx, reverseSearchWords,
+		arg.Query,
+		arg.SearchPattern,
+		arg.SourceLanguageID,
+		arg.LanguageID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReverseSearchWordsRow{}
+	for rows.Next() {
+		var i ReverseSearchWordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SenseID,
+			&i.SenseOrder,
+			&i.PartOfSpeechID,
+			&i.Definition,
+			&i.DefinitionLanguageID,
+			&i.MatchedBy,
+			&i.MatchedText,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchWords = `-- name: SearchWords :many
+WITH matches AS (
+  SELECT w.id,
+         CASE
+           WHEN LOWER(w.lemma) = LOWER($1::text)
+             OR w.lemma_normalized = LOWER($1::text)
+             OR w.search_key = LOWER($1::text) THEN 1
+           WHEN w.lemma ILIKE $2
+             OR w.lemma_normalized ILIKE $2
+             OR w.search_key ILIKE $2 THEN 2
+           WHEN w.lemma ILIKE $3
+             OR w.lemma_normalized ILIKE $3
+             OR w.search_key ILIKE $3 THEN 3
+           ELSE 4
+         END AS match_rank,
+         GREATEST(
+           similarity(w.lemma, $1::text),
+           similarity(COALESCE(w.lemma_normalized, ''), $1::text),
+           similarity(COALESCE(w.search_key, ''), $1::text)
+         ) + 0.25 / (1 + LOG(COALESCE(w.frequency_rank, 1000000)::float8 + 1)) AS score
+  FROM words w
+  WHERE w.language_id = $4
+    AND (
+      w.lemma ILIKE $3
+      OR w.lemma_normalized ILIKE $3
+      OR w.search_key ILIKE $3
+      OR w.lemma % $1::text
+      OR w.lemma_normalized % $1::text
+      OR w.search_key % $1::text
+    )
+    AND (
+      $5::bigint[] IS NULL
+      OR array_length($5::bigint[], 1) IS NULL
+      OR EXISTS (
+        SELECT 1
+        FROM word_topics wt
+        WHERE wt.word_id = w.id
+          AND wt.topic_id = ANY($5::bigint[])
+      )
+    )
+    AND (
+      -- Level and part of speech must be those of the same sense
+      ($6::bigint IS NULL AND $7::smallint IS NULL)
+      OR EXISTS (
+        SELECT 1
+        FROM senses s
+        WHERE s.word_id = w.id
+          AND ($6::bigint IS NULL OR s.level_id = $6)
+          AND ($7::smallint IS NULL OR s.part_of_speech_id = $7)
+      )
+    )
+    AND (
+      $8::boolean IS NULL
+      OR $8::boolean = EXISTS (
+        SELECT 1
+        FROM pronunciations p
+        WHERE p.word_id = w.id
+          AND p.audio_url IS NOT NULL
+          AND p.audio_url <> ''
+      )
+    )
+    AND ($9::text IS NULL OR w.script_code = $9)
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at
+FROM matches m
+INNER JOIN words w ON w.id = m.id
+ORDER BY m.match_rank, m.score DESC, w.frequency_rank NULLS LAST, w.id
+LIMIT $11 OFFSET $10
 `
 
 type SearchWordsParams struct {
-	LanguageID    int16  `json:"language_id"`
-	SearchPattern string `json:"search_pattern"`
-	ExactMatch    string `json:"exact_match"`
-	Offset        int32  `json:"offset"`
-	Limit         int32  `json:"limit"`
+	Query          string      `json:"query"`
+	PrefixPattern  string      `json:"prefix_pattern"`
+	SearchPattern  string      `json:"search_pattern"`
+	LanguageID     int16       `json:"language_id"`
+	TopicIds       []int64     `json:"topic_ids"`
+	LevelID        pgtype.Int8 `json:"level_id"`
+	PartOfSpeechID pgtype.Int2 `json:"part_of_speech_id"`
+	HasAudio       pgtype.Bool `json:"has_audio"`
+	ScriptCode     pgtype.Text `json:"script_code"`
+	Offset         int32       `json:"offset"`
+	Limit          int32       `json:"limit"`
 }
 
+// Words whose lemma, normalized form or search key contain the query, or are similar enough to it
+// to catch misspellings (trigram similarity above pg_trgm.similarity_threshold, 0.3 by default).
+// Every condition is served by the trigram indexes. Exact matches come first, then prefix matches,
+// then substring matches, then similar words; within each group words are ranked by similarity
+// boosted for frequent words. The optional filters narrow the words down by topics (any of them),
+// level and part of speech (of the same sense), audio pronunciation and script.
 func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]Word, error) {
 	rows, err := q.db.Query(ctx, searchWords,
-		arg.LanguageID,
+		arg.Query,
+		arg.PrefixPattern,
 		arg.SearchPattern,
-		arg.ExactMatch,
+		arg.LanguageID,
+		arg.TopicIds,
+		arg.LevelID,
+		arg.PartOfSpeechID,
+		arg.HasAudio,
+		arg.ScriptCode,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -436,3 +1417,67 @@ func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]Wor
 	}
 	return items, nil
 }
+
+const suggestWords = `-- name: SuggestWords :many
+WITH candidates AS (
+  (SELECT w.id, w.lemma <-> $1::text AS distance
+   FROM words w
+   WHERE w.language_id = $2
+   ORDER BY w.lemma <-> $1::text
+   LIMIT $3)
+  UNION ALL
+  (SELECT w.id, w.lemma_normalized <-> $1::text AS distance
+   FROM words w
+   WHERE w.language_id = $2
+   ORDER BY w.lemma_normalized <-> $1::text
+   LIMIT $3)
+  UNION ALL
+  (SELECT w.id, w.search_key <-> $1::text AS distance
+   FROM words w
+   WHERE w.language_id = $2
+   ORDER BY w.search_key <-> $1::text
+   LIMIT $3)
+)
+SELECT w.lemma
+FROM candidates c
+INNER JOIN words w ON w.id = c.id
+WHERE c.distance <= 1 - $4::float4
+GROUP BY w.lemma
+ORDER BY MIN(c.distance), MIN(w.frequency_rank) NULLS LAST, w.lemma
+LIMIT $3
+`
+
+type SuggestWordsParams struct {
+	Query         string  `json:"query"`
+	LanguageID    int16   `json:"language_id"`
+	Limit         int32   `json:"limit"`
+	MinSimilarity float32 `json:"min_similarity"`
+}
+
+// "Did you mean" lemmas for a query matching no word: the nearest words by trigram distance over
+// the lemma, the normalized form and the search key, each found by a GiST index scan, keeping those
+// at least min_similarity similar to the query
+func (q *Queries) SuggestWords(ctx context.Context, arg SuggestWordsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, suggestWords,
+		arg.Query,
+		arg.LanguageID,
+		arg.Limit,
+		arg.MinSimilarity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var lemma string
+		if err := rows.Scan(&lemma); err != nil {
+			return nil, err
+		}
+		items = append(items, lemma)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,12 +11,47 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countGameAnswersBySessionID = `-- name: CountGameAnswersBySessionID :one
+SELECT COUNT(*)
+FROM vocab_game_question_answers
+WHERE session_id = $1 AND user_id = $2
+`
+
+type CountGameAnswersBySessionIDParams struct {
+	SessionID int64 `json:"session_id"`
+	UserID    int64 `json:"user_id"`
+}
+
+func (q *Queries) CountGameAnswersBySessionID(ctx context.Context, arg CountGameAnswersBySessionIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countGameAnswersBySessionID, arg.SessionID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createGameAnswer = `-- name: CreateGameAnswer :one
-INSERT INTO vocab_game_question_answers (
-    question_id, session_id, user_id,
-    selected_option_id, is_correct, response_time_ms, answered_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, answered_at
+WITH inserted AS (
+    INSERT INTO vocab_game_question_answers (
+        question_id, session_id, user_id,
+        selected_option_id, is_correct, response_time_ms,
+        answer_text, match_result, answered_at
+    ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    RETURNING id, session_id, is_correct, answered_at
+),
+scored AS (
+    UPDATE vocab_game_sessions s
+    SET correct_questions = COALESCE(s.correct_questions, 0) + 1
+    FROM inserted i
+    WHERE s.id = i.session_id AND i.is_correct
+    RETURNING s.correct_questions
+)
+SELECT i.id, i.answered_at,
+       COALESCE(
+           (SELECT correct_questions FROM scored),
+           (SELECT s.correct_questions FROM vocab_game_sessions s WHERE s.id = i.session_id),
+           0
+       )::smallint AS correct_questions
+FROM inserted i
 `
 
 type CreateGameAnswerParams struct {
@@ -26,14 +61,20 @@ type CreateGameAnswerParams struct {
 	SelectedOptionID pgtype.Int8      `json:"selected_option_id"`
 	IsCorrect        bool             `json:"is_correct"`
 	ResponseTimeMs   pgtype.Int4      `json:"response_time_ms"`
+	AnswerText       pgtype.Text      `json:"answer_text"`
+	MatchResult      pgtype.Text      `json:"match_result"`
 	AnsweredAt       pgtype.Timestamp `json:"answered_at"`
 }
 
 type CreateGameAnswerRow struct {
-	ID         int64            `json:"id"`
-	AnsweredAt pgtype.Timestamp `json:"answered_at"`
+	ID               int64            `json:"id"`
+	AnsweredAt       pgtype.Timestamp `json:"answered_at"`
+	CorrectQuestions int16            `json:"correct_questions"`
 }
 
+// Stores the answer and, if it is correct, increments the correct count of its session
+// in the same statement. A second answer to the same question violates uq_vgqa_question_user
+// and leaves the session untouched.
 func (q *Queries) CreateGameAnswer(ctx context.Context, arg CreateGameAnswerParams) (CreateGameAnswerRow, error) {
 	row := q.db.QueryRow(ctx, createGameAnswer,
 		arg.QuestionID,
@@ -42,16 +83,19 @@ func (q *Queries) CreateGameAnswer(ctx context.Context, arg CreateGameAnswerPara
 		arg.SelectedOptionID,
 		arg.IsCorrect,
 		arg.ResponseTimeMs,
+		arg.AnswerText,
+		arg.MatchResult,
 		arg.AnsweredAt,
 	)
 	var i CreateGameAnswerRow
-	err := row.Scan(&i.ID, &i.AnsweredAt)
+	err := row.Scan(&i.ID, &i.AnsweredAt, &i.CorrectQuestions)
 	return i, err
 }
 
 const findGameAnswerByQuestionID = `-- name: FindGameAnswerByQuestionID :one
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms,
+       answer_text, match_result, answered_at
 FROM vocab_game_question_answers
 WHERE question_id = $1 AND session_id = $2 AND user_id = $3
 LIMIT 1
@@ -74,6 +118,8 @@ func (q *Queries) FindGameAnswerByQuestionID(ctx context.Context, arg FindGameAn
 		&i.SelectedOptionID,
 		&i.IsCorrect,
 		&i.ResponseTimeMs,
+		&i.AnswerText,
+		&i.MatchResult,
 		&i.AnsweredAt,
 	)
 	return i, err
@@ -81,7 +127,8 @@ func (q *Queries) FindGameAnswerByQuestionID(ctx context.Context, arg FindGameAn
 
 const findGameAnswersBySessionID = `-- name: FindGameAnswersBySessionID :many
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms,
+       answer_text, match_result, answered_at
 FROM vocab_game_question_answers
 WHERE session_id = $1 AND user_id = $2
 ORDER BY answered_at
@@ -109,6 +156,8 @@ func (q *Queries) FindGameAnswersBySessionID(ctx context.Context, arg FindGameAn
 			&i.SelectedOptionID,
 			&i.IsCorrect,
 			&i.ResponseTimeMs,
+			&i.AnswerText,
+			&i.MatchResult,
 			&i.AnsweredAt,
 		); err != nil {
 			return nil, err
@@ -120,3 +169,38 @@ func (q *Queries) FindGameAnswersBySessionID(ctx context.Context, arg FindGameAn
 	}
 	return items, nil
 }
+
+const findMissedWordIDsBySessionID = `-- name: FindMissedWordIDsBySessionID :many
+SELECT q.source_word_id
+FROM vocab_game_question_answers a
+JOIN vocab_game_questions q ON q.id = a.question_id
+WHERE a.session_id = $1 AND a.user_id = $2 AND a.is_correct = FALSE
+GROUP BY q.source_word_id
+ORDER BY MIN(q.question_order)
+`
+
+type FindMissedWordIDsBySessionIDParams struct {
+	SessionID int64 `json:"session_id"`
+	UserID    int64 `json:"user_id"`
+}
+
+// Source words of the questions answered wrongly by the user in a session, once each, in question order
+func (q *Queries) FindMissedWordIDsBySessionID(ctx context.Context, arg FindMissedWordIDsBySessionIDParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, findMissedWordIDsBySessionID, arg.SessionID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var source_word_id int64
+		if err := rows.Scan(&source_word_id); err != nil {
+			return nil, err
+		}
+		items = append(items, source_word_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,12 +9,24 @@ import (
 )
 
 type Querier interface {
+	CountDailyChallengeEntries(ctx context.Context, arg CountDailyChallengeEntriesParams) (int64, error)
+	CountGameAnswersBySessionID(ctx context.Context, arg CountGameAnswersBySessionIDParams) (int64, error)
 	CountGameSessionsByUserID(ctx context.Context, userID int64) (int64, error)
+	// Stores the answer and, if it is correct, increments the correct count of its session
+	// in the same statement. A second answer to the same question violates uq_vgqa_question_user
+	// and leaves the session untouched.
 	CreateGameAnswer(ctx context.Context, arg CreateGameAnswerParams) (CreateGameAnswerRow, error)
+	CreateGameDuel(ctx context.Context, arg CreateGameDuelParams) (int64, error)
+	CreateGameDuelPlayer(ctx context.Context, arg CreateGameDuelPlayerParams) error
 	CreateGameQuestion(ctx context.Context, arg CreateGameQuestionParams) (CreateGameQuestionRow, error)
 	CreateGameQuestionOption(ctx context.Context, arg CreateGameQuestionOptionParams) (int64, error)
 	CreateGameSession(ctx context.Context, arg CreateGameSessionParams) (CreateGameSessionRow, error)
-	EndGameSession(ctx context.Context, arg EndGameSessionParams) error
+	EndGameDuel(ctx context.Context, arg EndGameDuelParams) error
+	EndGameSession(ctx context.Context, arg EndGameSessionParams) (int64, error)
+	// Ended daily challenge sessions of a day and language pair, ranked by score and then by
+	// the time from the start of the session to its last answer, measured by the server; ties
+	// share a rank
+	FindDailyChallengeLeaderboard(ctx context.Context, arg FindDailyChallengeLeaderboardParams) ([]FindDailyChallengeLeaderboardRow, error)
 	FindGameAnswerByQuestionID(ctx context.Context, arg FindGameAnswerByQuestionIDParams) (VocabGameQuestionAnswer, error)
 	FindGameAnswersBySessionID(ctx context.Context, arg FindGameAnswersBySessionIDParams) ([]VocabGameQuestionAnswer, error)
 	FindGameQuestionByID(ctx context.Context, id int64) (VocabGameQuestion, error)
@@ -23,6 +35,12 @@ type Querier interface {
 	FindGameQuestionsBySessionID(ctx context.Context, sessionID int64) ([]VocabGameQuestion, error)
 	FindGameSessionByID(ctx context.Context, id int64) (VocabGameSession, error)
 	FindGameSessionsByUserID(ctx context.Context, arg FindGameSessionsByUserIDParams) ([]VocabGameSession, error)
+	// Source words of the questions answered wrongly by the user in a session, once each, in question order
+	FindMissedWordIDsBySessionID(ctx context.Context, arg FindMissedWordIDsBySessionIDParams) ([]int64, error)
+	// Whether the user lets other users watch their live progress; users without a profile do not
+	FindUserShareProgress(ctx context.Context, userID int64) (bool, error)
+	UpdateGameDuelPlayerResult(ctx context.Context, arg UpdateGameDuelPlayerResultParams) error
+	// correct_questions is only changed by CreateGameAnswer and EndGameSession
 	UpdateGameSession(ctx context.Context, arg UpdateGameSessionParams) error
 }
 
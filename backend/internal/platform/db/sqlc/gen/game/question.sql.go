@@ -15,8 +15,8 @@ const createGameQuestion = `-- name: CreateGameQuestion :one
 INSERT INTO vocab_game_questions (
     session_id, question_order, question_type,
     source_word_id, source_sense_id, correct_target_word_id,
-    source_language_id, target_language_id, created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    source_language_id, target_language_id, prompt_text, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING id, created_at
 `
 
@@ -29,6 +29,7 @@ type CreateGameQuestionParams struct {
 	CorrectTargetWordID int64            `json:"correct_target_word_id"`
 	SourceLanguageID    int16            `json:"source_language_id"`
 	TargetLanguageID    int16            `json:"target_language_id"`
+	PromptText          pgtype.Text      `json:"prompt_text"`
 	CreatedAt           pgtype.Timestamp `json:"created_at"`
 }
 
@@ -47,6 +48,7 @@ func (q *Queries) CreateGameQuestion(ctx context.Context, arg CreateGameQuestion
 		arg.CorrectTargetWordID,
 		arg.SourceLanguageID,
 		arg.TargetLanguageID,
+		arg.PromptText,
 		arg.CreatedAt,
 	)
 	var i CreateGameQuestionRow
@@ -83,7 +85,7 @@ func (q *Queries) CreateGameQuestionOption(ctx context.Context, arg CreateGameQu
 const findGameQuestionByID = `-- name: FindGameQuestionByID :one
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, prompt_text, created_at
 FROM vocab_game_questions
 WHERE id = $1
 `
@@ -101,6 +103,7 @@ func (q *Queries) FindGameQuestionByID(ctx context.Context, id int64) (VocabGame
 		&i.CorrectTargetWordID,
 		&i.SourceLanguageID,
 		&i.TargetLanguageID,
+		&i.PromptText,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -175,7 +178,7 @@ func (q *Queries) FindGameQuestionOptionsByQuestionIDs(ctx context.Context, doll
 const findGameQuestionsBySessionID = `-- name: FindGameQuestionsBySessionID :many
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, prompt_text, created_at
 FROM vocab_game_questions
 WHERE session_id = $1
 ORDER BY question_order
@@ -200,6 +203,7 @@ func (q *Queries) FindGameQuestionsBySessionID(ctx context.Context, sessionID in
 			&i.CorrectTargetWordID,
 			&i.SourceLanguageID,
 			&i.TargetLanguageID,
+			&i.PromptText,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -11,6 +11,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countDailyChallengeEntries = `-- name: CountDailyChallengeEntries :one
+SELECT COUNT(*)
+FROM vocab_game_sessions
+WHERE challenge_date = $1
+  AND source_language_id = $2
+  AND target_language_id = $3
+  AND ended_at IS NOT NULL
+`
+
+type CountDailyChallengeEntriesParams struct {
+	ChallengeDate    pgtype.Date `json:"challenge_date"`
+	SourceLanguageID int16       `json:"source_language_id"`
+	TargetLanguageID int16       `json:"target_language_id"`
+}
+
+func (q *Queries) CountDailyChallengeEntries(ctx context.Context, arg CountDailyChallengeEntriesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDailyChallengeEntries, arg.ChallengeDate, arg.SourceLanguageID, arg.TargetLanguageID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countGameSessionsByUserID = `-- name: CountGameSessionsByUserID :one
 SELECT COUNT(*)
 FROM vocab_game_sessions
@@ -28,21 +50,24 @@ const createGameSession = `-- name: CreateGameSession :one
 INSERT INTO vocab_game_sessions (
     user_id, mode, source_language_id, target_language_id,
     topic_id, level_id, total_questions, correct_questions,
-    started_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    started_at, option_count, question_time_limit_ms, challenge_date
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING id, started_at
 `
 
 type CreateGameSessionParams struct {
-	UserID           int64            `json:"user_id"`
-	Mode             string           `json:"mode"`
-	SourceLanguageID int16            `json:"source_language_id"`
-	TargetLanguageID int16            `json:"target_language_id"`
-	TopicID          pgtype.Int8      `json:"topic_id"`
-	LevelID          pgtype.Int8      `json:"level_id"`
-	TotalQuestions   pgtype.Int2      `json:"total_questions"`
-	CorrectQuestions pgtype.Int2      `json:"correct_questions"`
-	StartedAt        pgtype.Timestamp `json:"started_at"`
+	UserID              int64            `json:"user_id"`
+	Mode                string           `json:"mode"`
+	SourceLanguageID    int16            `json:"source_language_id"`
+	TargetLanguageID    int16            `json:"target_language_id"`
+	TopicID             pgtype.Int8      `json:"topic_id"`
+	LevelID             pgtype.Int8      `json:"level_id"`
+	TotalQuestions      pgtype.Int2      `json:"total_questions"`
+	CorrectQuestions    pgtype.Int2      `json:"correct_questions"`
+	StartedAt           pgtype.Timestamp `json:"started_at"`
+	OptionCount         pgtype.Int2      `json:"option_count"`
+	QuestionTimeLimitMs pgtype.Int4      `json:"question_time_limit_ms"`
+	ChallengeDate       pgtype.Date      `json:"challenge_date"`
 }
 
 type CreateGameSessionRow struct {
@@ -61,16 +86,24 @@ func (q *Queries) CreateGameSession(ctx context.Context, arg CreateGameSessionPa
 		arg.TotalQuestions,
 		arg.CorrectQuestions,
 		arg.StartedAt,
+		arg.OptionCount,
+		arg.QuestionTimeLimitMs,
+		arg.ChallengeDate,
 	)
 	var i CreateGameSessionRow
 	err := row.Scan(&i.ID, &i.StartedAt)
 	return i, err
 }
 
-const endGameSession = `-- name: EndGameSession :exec
-UPDATE vocab_game_sessions 
-SET ended_at = $2 
-WHERE id = $1
+const endGameSession = `-- name: EndGameSession :execrows
+UPDATE vocab_game_sessions
+SET ended_at = $2,
+    correct_questions = (
+        SELECT COUNT(*)
+        FROM vocab_game_question_answers a
+        WHERE a.session_id = vocab_game_sessions.id AND a.is_correct = TRUE
+    )
+WHERE id = $1 AND ended_at IS NULL
 `
 
 type EndGameSessionParams struct {
@@ -78,15 +111,100 @@ type EndGameSessionParams struct {
 	EndedAt pgtype.Timestamp `json:"ended_at"`
 }
 
-func (q *Queries) EndGameSession(ctx context.Context, arg EndGameSessionParams) error {
-	_, err := q.db.Exec(ctx, endGameSession, arg.ID, arg.EndedAt)
-	return err
+func (q *Queries) EndGameSession(ctx context.Context, arg EndGameSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, endGameSession, arg.ID, arg.EndedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findDailyChallengeLeaderboard = `-- name: FindDailyChallengeLeaderboard :many
+WITH results AS (
+    SELECT s.id, s.user_id, s.ended_at,
+           COALESCE(s.correct_questions, 0)::smallint AS correct_questions,
+           COALESCE(s.total_questions, 0)::smallint AS total_questions,
+           (EXTRACT(EPOCH FROM COALESCE(MAX(a.answered_at), s.ended_at) - s.started_at) * 1000)::bigint AS total_response_time_ms
+    FROM vocab_game_sessions s
+    LEFT JOIN vocab_game_question_answers a ON a.session_id = s.id
+    WHERE s.challenge_date = $1
+      AND s.source_language_id = $2
+      AND s.target_language_id = $3
+      AND s.ended_at IS NOT NULL
+    GROUP BY s.id
+)
+SELECT RANK() OVER (ORDER BY r.correct_questions DESC, r.total_response_time_ms) AS rank,
+       r.id AS session_id, r.user_id,
+       COALESCE(p.display_name, u.username, '')::text AS display_name,
+       r.correct_questions, r.total_questions, r.total_response_time_ms, r.ended_at
+FROM results r
+JOIN users u ON u.id = r.user_id
+LEFT JOIN user_profiles p ON p.user_id = r.user_id
+ORDER BY rank, r.ended_at, r.id
+LIMIT $5 OFFSET $4
+`
+
+type FindDailyChallengeLeaderboardParams struct {
+	ChallengeDate    pgtype.Date `json:"challenge_date"`
+	SourceLanguageID int16       `json:"source_language_id"`
+	TargetLanguageID int16       `json:"target_language_id"`
+	Offset           int32       `json:"offset"`
+	Limit            int32       `json:"limit"`
+}
+
+type FindDailyChallengeLeaderboardRow struct {
+	Rank                int64            `json:"rank"`
+	SessionID           int64            `json:"session_id"`
+	UserID              int64            `json:"user_id"`
+	DisplayName         string           `json:"display_name"`
+	CorrectQuestions    int16            `json:"correct_questions"`
+	TotalQuestions      int16            `json:"total_questions"`
+	TotalResponseTimeMs int64            `json:"total_response_time_ms"`
+	EndedAt             pgtype.Timestamp `json:"ended_at"`
+}
+
+// Ended daily challenge sessions of a day and language pair, ranked by score and then by
+// the time from the start of the session to its last answer, measured by the server; ties
+// share a rank
+func (q *Queries) FindDailyChallengeLeaderboard(ctx context.Context, arg FindDailyChallengeLeaderboardParams) ([]FindDailyChallengeLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, findDailyChallengeLeaderboard,
+		arg.ChallengeDate,
+		arg.SourceLanguageID,
+		arg.TargetLanguageID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindDailyChallengeLeaderboardRow{}
+	for rows.Next() {
+		var i FindDailyChallengeLeaderboardRow
+		if err := rows.Scan(
+			&i.Rank,
+			&i.SessionID,
+			&i.UserID,
+			&i.DisplayName,
+			&i.CorrectQuestions,
+			&i.TotalQuestions,
+			&i.TotalResponseTimeMs,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const findGameSessionByID = `-- name: FindGameSessionByID :one
 SELECT id, user_id, mode, source_language_id, target_language_id,
        topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       started_at, ended_at, option_count, question_time_limit_ms, challenge_date
 FROM vocab_game_sessions
 WHERE id = $1
 `
@@ -106,6 +224,9 @@ func (q *Queries) FindGameSessionByID(ctx context.Context, id int64) (VocabGameS
 		&i.CorrectQuestions,
 		&i.StartedAt,
 		&i.EndedAt,
+		&i.OptionCount,
+		&i.QuestionTimeLimitMs,
+		&i.ChallengeDate,
 	)
 	return i, err
 }
@@ -113,7 +234,7 @@ func (q *Queries) FindGameSessionByID(ctx context.Context, id int64) (VocabGameS
 const findGameSessionsByUserID = `-- name: FindGameSessionsByUserID :many
 SELECT id, user_id, mode, source_language_id, target_language_id,
        topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       started_at, ended_at, option_count, question_time_limit_ms, challenge_date
 FROM vocab_game_sessions
 WHERE user_id = $1
 ORDER BY started_at DESC
@@ -147,6 +268,9 @@ func (q *Queries) FindGameSessionsByUserID(ctx context.Context, arg FindGameSess
 			&i.CorrectQuestions,
 			&i.StartedAt,
 			&i.EndedAt,
+			&i.OptionCount,
+			&i.QuestionTimeLimitMs,
+			&i.ChallengeDate,
 		); err != nil {
 			return nil, err
 		}
@@ -161,24 +285,18 @@ func (q *Queries) FindGameSessionsByUserID(ctx context.Context, arg FindGameSess
 const updateGameSession = `-- name: UpdateGameSession :exec
 UPDATE vocab_game_sessions
 SET total_questions = $2,
-    correct_questions = $3,
-    ended_at = $4
+    ended_at = $3
 WHERE id = $1
 `
 
 type UpdateGameSessionParams struct {
-	ID               int64            `json:"id"`
-	TotalQuestions   pgtype.Int2      `json:"total_questions"`
-	CorrectQuestions pgtype.Int2      `json:"correct_questions"`
-	EndedAt          pgtype.Timestamp `json:"ended_at"`
+	ID             int64            `json:"id"`
+	TotalQuestions pgtype.Int2      `json:"total_questions"`
+	EndedAt        pgtype.Timestamp `json:"ended_at"`
 }
 
+// correct_questions is only changed by CreateGameAnswer and EndGameSession
 func (q *Queries) UpdateGameSession(ctx context.Context, arg UpdateGameSessionParams) error {
-	_, err := q.db.Exec(ctx, updateGameSession,
-		arg.ID,
-		arg.TotalQuestions,
-		arg.CorrectQuestions,
-		arg.EndedAt,
-	)
+	_, err := q.db.Exec(ctx, updateGameSession, arg.ID, arg.TotalQuestions, arg.EndedAt)
 	return err
 }
@@ -110,14 +110,34 @@ type User struct {
 	IsActive     pgtype.Bool      `json:"is_active"`
 }
 
+type UserAchievement struct {
+	UserID          int64            `json:"user_id"`
+	AchievementCode string           `json:"achievement_code"`
+	EarnedAt        pgtype.Timestamp `json:"earned_at"`
+}
+
+type UserPeriodStatistic struct {
+	UserID           int64            `json:"user_id"`
+	PeriodType       string           `json:"period_type"`
+	PeriodStart      pgtype.Date      `json:"period_start"`
+	SourceLanguageID int16            `json:"source_language_id"`
+	TargetLanguageID int16            `json:"target_language_id"`
+	LevelID          int64            `json:"level_id"`
+	TotalQuestions   int32            `json:"total_questions"`
+	TotalCorrect     int32            `json:"total_correct"`
+	LastPlayedAt     pgtype.Timestamp `json:"last_played_at"`
+}
+
 type UserProfile struct {
-	UserID      int64            `json:"user_id"`
-	DisplayName pgtype.Text      `json:"display_name"`
-	AvatarUrl   pgtype.Text      `json:"avatar_url"`
-	BirthDay    pgtype.Date      `json:"birth_day"`
-	Bio         pgtype.Text      `json:"bio"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
-	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	UserID        int64            `json:"user_id"`
+	DisplayName   pgtype.Text      `json:"display_name"`
+	AvatarUrl     pgtype.Text      `json:"avatar_url"`
+	BirthDay      pgtype.Date      `json:"birth_day"`
+	Bio           pgtype.Text      `json:"bio"`
+	Timezone      pgtype.Text      `json:"timezone"`
+	ShareProgress pgtype.Bool      `json:"share_progress"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
 }
 
 type UserStatistic struct {
@@ -127,6 +147,10 @@ type UserStatistic struct {
 	TotalCorrect     pgtype.Int4      `json:"total_correct"`
 	TotalTimeSeconds pgtype.Int4      `json:"total_time_seconds"`
 	LastPlayedAt     pgtype.Timestamp `json:"last_played_at"`
+	CurrentStreak    pgtype.Int4      `json:"current_streak"`
+	LongestStreak    pgtype.Int4      `json:"longest_streak"`
+	LastPlayedOn     pgtype.Date      `json:"last_played_on"`
+	TotalXp          pgtype.Int4      `json:"total_xp"`
 }
 
 type UserTopicStatistic struct {
@@ -144,6 +168,25 @@ type UserWordStatistic struct {
 	WrongCount     pgtype.Int4      `json:"wrong_count"`
 	LastAnsweredAt pgtype.Timestamp `json:"last_answered_at"`
 	Streak         pgtype.Int4      `json:"streak"`
+	EaseFactor     float64          `json:"ease_factor"`
+	IntervalDays   int32            `json:"interval_days"`
+	Repetitions    int32            `json:"repetitions"`
+	DueAt          pgtype.Timestamp `json:"due_at"`
+}
+
+type VocabGameDuel struct {
+	ID        int64            `json:"id"`
+	RoomCode  string           `json:"room_code"`
+	StartedAt pgtype.Timestamp `json:"started_at"`
+	EndedAt   pgtype.Timestamp `json:"ended_at"`
+}
+
+type VocabGameDuelPlayer struct {
+	DuelID    int64       `json:"duel_id"`
+	UserID    int64       `json:"user_id"`
+	SessionID int64       `json:"session_id"`
+	Points    int16       `json:"points"`
+	Rank      pgtype.Int2 `json:"rank"`
 }
 
 type VocabGameQuestion struct {
@@ -156,6 +199,7 @@ type VocabGameQuestion struct {
 	CorrectTargetWordID int64            `json:"correct_target_word_id"`
 	SourceLanguageID    int16            `json:"source_language_id"`
 	TargetLanguageID    int16            `json:"target_language_id"`
+	PromptText          pgtype.Text      `json:"prompt_text"`
 	CreatedAt           pgtype.Timestamp `json:"created_at"`
 }
 
@@ -167,6 +211,8 @@ type VocabGameQuestionAnswer struct {
 	SelectedOptionID pgtype.Int8      `json:"selected_option_id"`
 	IsCorrect        bool             `json:"is_correct"`
 	ResponseTimeMs   pgtype.Int4      `json:"response_time_ms"`
+	AnswerText       pgtype.Text      `json:"answer_text"`
+	MatchResult      pgtype.Text      `json:"match_result"`
 	AnsweredAt       pgtype.Timestamp `json:"answered_at"`
 }
 
@@ -179,17 +225,20 @@ type VocabGameQuestionOption struct {
 }
 
 type VocabGameSession struct {
-	ID               int64            `json:"id"`
-	UserID           int64            `json:"user_id"`
-	Mode             string           `json:"mode"`
-	SourceLanguageID int16            `json:"source_language_id"`
-	TargetLanguageID int16            `json:"target_language_id"`
-	TopicID          pgtype.Int8      `json:"topic_id"`
-	LevelID          pgtype.Int8      `json:"level_id"`
-	TotalQuestions   pgtype.Int2      `json:"total_questions"`
-	CorrectQuestions pgtype.Int2      `json:"correct_questions"`
-	StartedAt        pgtype.Timestamp `json:"started_at"`
-	EndedAt          pgtype.Timestamp `json:"ended_at"`
+	ID                  int64            `json:"id"`
+	UserID              int64            `json:"user_id"`
+	Mode                string           `json:"mode"`
+	SourceLanguageID    int16            `json:"source_language_id"`
+	TargetLanguageID    int16            `json:"target_language_id"`
+	TopicID             pgtype.Int8      `json:"topic_id"`
+	LevelID             pgtype.Int8      `json:"level_id"`
+	TotalQuestions      pgtype.Int2      `json:"total_questions"`
+	CorrectQuestions    pgtype.Int2      `json:"correct_questions"`
+	StartedAt           pgtype.Timestamp `json:"started_at"`
+	EndedAt             pgtype.Timestamp `json:"ended_at"`
+	OptionCount         pgtype.Int2      `json:"option_count"`
+	QuestionTimeLimitMs pgtype.Int4      `json:"question_time_limit_ms"`
+	ChallengeDate       pgtype.Date      `json:"challenge_date"`
 }
 
 type Word struct {
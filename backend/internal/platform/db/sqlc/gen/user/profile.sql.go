@@ -12,9 +12,9 @@ import (
 )
 
 const createUserProfile = `-- name: CreateUserProfile :one
-INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio, timezone)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at
 `
 
 type CreateUserProfileParams struct {
@@ -23,6 +23,7 @@ type CreateUserProfileParams struct {
 	AvatarUrl   pgtype.Text `json:"avatar_url"`
 	BirthDay    pgtype.Date `json:"birth_day"`
 	Bio         pgtype.Text `json:"bio"`
+	Timezone    pgtype.Text `json:"timezone"`
 }
 
 func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfileParams) (UserProfile, error) {
@@ -32,6 +33,7 @@ func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfilePa
 		arg.AvatarUrl,
 		arg.BirthDay,
 		arg.Bio,
+		arg.Timezone,
 	)
 	var i UserProfile
 	err := row.Scan(
@@ -40,6 +42,8 @@ func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfilePa
 		&i.AvatarUrl,
 		&i.BirthDay,
 		&i.Bio,
+		&i.Timezone,
+		&i.ShareProgress,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -47,7 +51,7 @@ func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfilePa
 }
 
 const getUserProfile = `-- name: GetUserProfile :one
-SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+SELECT user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at
 FROM user_profiles
 WHERE user_id = $1
 `
@@ -61,6 +65,8 @@ func (q *Queries) GetUserProfile(ctx context.Context, userID int64) (UserProfile
 		&i.AvatarUrl,
 		&i.BirthDay,
 		&i.Bio,
+		&i.Timezone,
+		&i.ShareProgress,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -73,17 +79,21 @@ SET display_name = COALESCE($2, display_name),
     avatar_url = COALESCE($3, avatar_url),
     birth_day = COALESCE($4, birth_day),
     bio = COALESCE($5, bio),
+    timezone = COALESCE($6, timezone),
+    share_progress = COALESCE($7, share_progress),
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+RETURNING user_id, display_name, avatar_url, birth_day, bio, timezone, share_progress, created_at, updated_at
 `
 
 type UpdateUserProfileParams struct {
-	UserID      int64       `json:"user_id"`
-	DisplayName pgtype.Text `json:"display_name"`
-	AvatarUrl   pgtype.Text `json:"avatar_url"`
-	BirthDay    pgtype.Date `json:"birth_day"`
-	Bio         pgtype.Text `json:"bio"`
+	UserID        int64       `json:"user_id"`
+	DisplayName   pgtype.Text `json:"display_name"`
+	AvatarUrl     pgtype.Text `json:"avatar_url"`
+	BirthDay      pgtype.Date `json:"birth_day"`
+	Bio           pgtype.Text `json:"bio"`
+	Timezone      pgtype.Text `json:"timezone"`
+	ShareProgress pgtype.Bool `json:"share_progress"`
 }
 
 func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UserProfile, error) {
@@ -93,6 +103,8 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 		arg.AvatarUrl,
 		arg.BirthDay,
 		arg.Bio,
+		arg.Timezone,
+		arg.ShareProgress,
 	)
 	var i UserProfile
 	err := row.Scan(
@@ -101,6 +113,8 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 		&i.AvatarUrl,
 		&i.BirthDay,
 		&i.Bio,
+		&i.Timezone,
+		&i.ShareProgress,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
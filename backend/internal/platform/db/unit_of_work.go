@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// txContextKey is the context key of the transaction started by a unit of work
+type txContextKey struct{}
+
+// UnitOfWork runs functions in a transaction carried by the context, see uow.UnitOfWork
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork creates a new unit of work over the connection pool
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{pool: pool}
+}
+
+// Do runs fn in the transaction of ctx if there is one, or in a new transaction otherwise
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return RunInTx(ctx, u.pool, fn)
+}
+
+// RunInTx runs fn in the transaction carried by ctx, or in a new transaction committed when fn succeeds.
+// The context passed to fn carries the transaction so that repositories called with it join it.
+func RunInTx(ctx context.Context, pool *pgxpool.Pool, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	return WithTx(ctx, pool, func(tx pgx.Tx) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// TxFromContext returns the transaction carried by ctx, if any
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(pgx.Tx)
+	return tx, ok
+}
@@ -0,0 +1,84 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/english-coach/backend/internal/shared/eventbus"
+)
+
+// subscriberBufferSize is the number of events queued for a subscriber before further events are dropped
+const subscriberBufferSize = 64
+
+// subscriber is a subscription to the events of some topics
+type subscriber struct {
+	events chan eventbus.Event
+	topics []string
+}
+
+// MemoryBus delivers events to subscribers of the same process, see eventbus.Bus.
+// It serves a single API instance; replicas need a bus shared across processes.
+type MemoryBus struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+// NewMemoryBus creates a new in-process event bus
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		subscribers: make(map[string]map[*subscriber]struct{}),
+	}
+}
+
+// Publish delivers an event to the current subscribers of its topic, skipping those whose buffer is full
+func (b *MemoryBus) Publish(ctx context.Context, event eventbus.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers[event.Topic] {
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe returns the events published on the topics until ctx is done, then the channel is closed
+func (b *MemoryBus) Subscribe(ctx context.Context, topics ...string) (<-chan eventbus.Event, error) {
+	sub := &subscriber{
+		events: make(chan eventbus.Event, subscriberBufferSize),
+		topics: topics,
+	}
+
+	b.mu.Lock()
+	for _, topic := range topics {
+		if b.subscribers[topic] == nil {
+			b.subscribers[topic] = make(map[*subscriber]struct{})
+		}
+		b.subscribers[topic][sub] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.events, nil
+}
+
+// unsubscribe removes a subscriber and closes its channel; publishers hold the read lock while sending,
+// so the channel is never closed during a send
+func (b *MemoryBus) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, topic := range sub.topics {
+		delete(b.subscribers[topic], sub)
+		if len(b.subscribers[topic]) == 0 {
+			delete(b.subscribers, topic)
+		}
+	}
+	close(sub.events)
+}
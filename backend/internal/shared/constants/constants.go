@@ -10,6 +10,69 @@ const (
 
 	// MinGameQuestionCount is the minimum number of questions per vocabgame session
 	MinGameQuestionCount = 1
+
+	// DefaultGameOptionCount is the default number of options per multiple-choice question
+	DefaultGameOptionCount = 4
+
+	// MaxGameOptionCount is the maximum number of options per multiple-choice question
+	MaxGameOptionCount = 6
+
+	// MinGameOptionCount is the minimum number of options per multiple-choice question
+	MinGameOptionCount = 2
+
+	// MinQuestionTimeLimitMs is the minimum time limit per question (in milliseconds)
+	MinQuestionTimeLimitMs = 3000 // 3 seconds
+
+	// MaxQuestionTimeLimitMs is the maximum time limit per question (in milliseconds)
+	MaxQuestionTimeLimitMs = 300000 // 5 minutes
+
+	// DailyChallengeQuestionCount is the number of questions of the daily challenge
+	DailyChallengeQuestionCount = 10
+
+	// DailyChallengeQuestionTimeLimitMs is the time limit per question of the daily challenge (in milliseconds)
+	DailyChallengeQuestionTimeLimitMs = 15000 // 15 seconds
+
+	// DuelQuestionCount is the default number of questions of a duel
+	DuelQuestionCount = 10
+
+	// DuelRoundTimeoutMs is the time a duel question stays open when nobody answers it correctly (in milliseconds)
+	DuelRoundTimeoutMs = 20000 // 20 seconds
+
+	// DuelMinPlayers is the minimum number of players to start a duel
+	DuelMinPlayers = 2
+
+	// DuelMaxPlayers is the maximum number of players of a duel room
+	DuelMaxPlayers = 8
+
+	// MaxWatchedProgressUsers is the maximum number of users whose progress one stream watches
+	MaxWatchedProgressUsers = 50
+
+	// ProgressKeepAliveSeconds is the interval of the keep-alive comments of a progress stream (in seconds)
+	ProgressKeepAliveSeconds = 15
+)
+
+// Leaderboard constants
+const (
+	// LeaderboardAccuracyMinQuestions is the number of answers in the period needed to be ranked by accuracy
+	LeaderboardAccuracyMinQuestions = 20
+)
+
+// Dictionary constants
+const (
+	// DictionarySuggestionLimit is the maximum number of "did you mean" suggestions of a search
+	DictionarySuggestionLimit = 5
+
+	// DictionarySuggestionMinSimilarity is the trigram similarity to the query a suggestion needs at least
+	DictionarySuggestionMinSimilarity = 0.2
+
+	// DefaultAutocompleteLimit is the default number of words suggested while typing a search
+	DefaultAutocompleteLimit = 10
+
+	// MaxAutocompleteLimit is the maximum number of words suggested while typing a search
+	MaxAutocompleteLimit = 20
+
+	// WordPrefixIndexRefreshSeconds is the interval of the checks for changed words to re-index (in seconds)
+	WordPrefixIndexRefreshSeconds = 60
 )
 
 // API constants
@@ -40,6 +40,17 @@ const (
 	CodeInvalidMode            = "INVALID_MODE"
 	CodeSessionNotOwned        = "SESSION_NOT_OWNED"
 	CodeTranslationNotFound    = "TRANSLATION_NOT_FOUND"
+	CodeNoDueReviews           = "NO_DUE_REVIEWS"
+	CodeAnswerTimeExpired      = "ANSWER_TIME_EXPIRED"
+	CodeSessionNotEnded        = "SESSION_NOT_ENDED"
+	CodeNoMistakesToRetry      = "NO_MISTAKES_TO_RETRY"
+	CodeDailyChallengePlayed   = "DAILY_CHALLENGE_PLAYED"
+	CodeDuelRoomNotFound       = "DUEL_ROOM_NOT_FOUND"
+	CodeDuelRoomFull           = "DUEL_ROOM_FULL"
+	CodeDuelAlreadyStarted     = "DUEL_ALREADY_STARTED"
+	CodeDuelNotHost            = "DUEL_NOT_HOST"
+	CodeDuelNotEnoughPlayers   = "DUEL_NOT_ENOUGH_PLAYERS"
+	CodeProgressNotShared      = "PROGRESS_NOT_SHARED"
 )
 
 // Dictionary domain error codes
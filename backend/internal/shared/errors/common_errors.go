@@ -36,6 +36,17 @@ var (
 	ErrInvalidMode            = NewAppError(CodeInvalidMode, "Chế độ không hợp lệ")
 	ErrSessionNotOwned        = NewAppError(CodeSessionNotOwned, "Phiên chơi không thuộc về người dùng này")
 	ErrTranslationNotFound    = NewAppError(CodeTranslationNotFound, "Không tìm thấy bản dịch cho từ này")
+	ErrNoDueReviews           = NewAppError(CodeNoDueReviews, "Chưa có từ nào cần ôn tập. Vui lòng quay lại sau")
+	ErrAnswerTimeExpired      = NewAppError(CodeAnswerTimeExpired, "Đã hết thời gian trả lời câu hỏi này")
+	ErrSessionNotEnded        = NewAppError(CodeSessionNotEnded, "Phiên chơi chưa kết thúc, hãy hoàn thành trước khi xem lại")
+	ErrNoMistakesToRetry      = NewAppError(CodeNoMistakesToRetry, "Phiên chơi không có câu trả lời sai nào để luyện lại")
+	ErrDailyChallengePlayed   = NewAppError(CodeDailyChallengePlayed, "Bạn đã chơi thử thách hôm nay, hãy quay lại vào ngày mai")
+	ErrDuelRoomNotFound       = NewAppError(CodeDuelRoomNotFound, "Không tìm thấy phòng đấu")
+	ErrDuelRoomFull           = NewAppError(CodeDuelRoomFull, "Phòng đấu đã đủ người chơi")
+	ErrDuelAlreadyStarted     = NewAppError(CodeDuelAlreadyStarted, "Trận đấu đã bắt đầu")
+	ErrDuelNotHost            = NewAppError(CodeDuelNotHost, "Chỉ chủ phòng mới có thể bắt đầu trận đấu")
+	ErrDuelNotEnoughPlayers   = NewAppError(CodeDuelNotEnoughPlayers, "Cần ít nhất 2 người chơi để bắt đầu trận đấu")
+	ErrProgressNotShared      = NewAppError(CodeProgressNotShared, "Người dùng chưa chia sẻ tiến độ học")
 
 	// Dictionary domain errors
 	ErrWordNotFound         = NewAppError(CodeWordNotFound, "Không tìm thấy từ")
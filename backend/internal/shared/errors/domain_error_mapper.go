@@ -77,7 +77,7 @@ func MapVocabGameRepositoryError(err error, operation string) error {
 			// Answer not found is not necessarily an error - might be first time answering
 			// Return as-is, let usecase decide
 			return err
-		case "FindGameAnswersBySessionID":
+		case "FindGameAnswersBySessionID", "CountGameAnswersBySessionID":
 			// FindGameAnswersBySessionID returns empty slice if not found, not an error
 			// But if there's a DB error, return as-is
 			return err
@@ -91,11 +91,17 @@ func MapVocabGameRepositoryError(err error, operation string) error {
 		}
 	}
 
-	// Check for unique violation errors (if any unique constraints exist)
+	// Check for unique violation errors
 	if IsUniqueViolation(err) {
-		// VocabGame domain doesn't have unique constraints that need special handling
-		// Return as-is, let usecase handle
-		return err
+		switch GetUniqueConstraintField(err) {
+		case "uq_vgqa_question_user":
+			return vocabgamedomain.ErrAnswerAlreadySubmitted
+		case "uq_vgs_daily_challenge":
+			return vocabgamedomain.ErrDailyChallengePlayed
+		default:
+			// Return as-is, let usecase handle
+			return err
+		}
 	}
 
 	// For other errors, return as-is
@@ -120,9 +126,10 @@ func MapDictionaryRepositoryError(err error, operation string) error {
 		// These should not return "not found" errors, but if they do, it's a DB error
 		switch operation {
 		case "FindWordsByIDs", "FindWordsByTopicAndLanguages", "FindWordsByLevelAndLanguages",
-			"FindWordsByLevelAndTopicsAndLanguages", "FindTranslationsForWord",
-			"SearchWords", "CountSearchWords", "FindAllLanguages", "FindAllTopics", "FindAllLevels", "FindAllPartsOfSpeech",
-			"FindLevelsByLanguageID", "FindSensesByWordID", "FindSensesByWordIDs":
+			"FindWordsByLevelAndTopicsAndLanguages", "FindWordsAcrossLevelsAndLanguages", "FindTranslationsForWord",
+			"FindTranslationsForSense", "FindDistractorWords", "SearchWords", "CountSearchWords", "CountSearchWordsByLevel", "CountSearchWordsByTopic", "ReverseSearchWords", "CountReverseSearchWords", "SuggestWords", "ListWords", "CountListWords", "FindAllWords", "FindWordIndexVersion", "FindAllLanguages", "FindAllTopics", "FindAllLevels", "FindAllPartsOfSpeech",
+			"FindLevelsByLanguageID", "FindSensesByWordID", "FindSensesByWordIDs",
+			"FindExamplesBySenseIDs", "FindPronunciationsByWordIDs":
 			// These operations return empty results if not found, not an error
 			// But if there's a DB error, return as-is
 			return err
@@ -156,3 +163,27 @@ func MapDictionaryRepositoryError(err error, operation string) error {
 	// For other errors, return as-is
 	return err
 }
+
+// MapStatisticsRepositoryError translates technical errors to statistics domain errors
+func MapStatisticsRepositoryError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Statistics aggregates are upserted and read as collections,
+	// so there are no domain-specific errors to translate yet.
+	// Return as-is, let usecase handle
+	return err
+}
+
+// MapReviewRepositoryError translates technical errors to review domain errors
+func MapReviewRepositoryError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// A missing review state means the word has never been scheduled,
+	// which the repository reports as nil rather than an error.
+	// Return as-is, let usecase handle
+	return err
+}
@@ -39,7 +39,8 @@ func mapCodeToHTTPStatus(code string) int {
 	case CodeInvalidRequest, CodeInvalidParameter, CodeValidationError,
 		CodeEmailRequired, CodeInvalidPassword, CodeInvalidMode,
 		CodeInsufficientWords, CodeSessionEnded, CodeQuestionNotInSession,
-		CodeAnswerAlreadySubmitted:
+		CodeAnswerAlreadySubmitted, CodeNoDueReviews, CodeAnswerTimeExpired,
+		CodeSessionNotEnded, CodeNoMistakesToRetry, CodeDuelNotEnoughPlayers:
 		return http.StatusBadRequest
 
 	// 401 Unauthorized
@@ -47,17 +48,18 @@ func mapCodeToHTTPStatus(code string) int {
 		return http.StatusUnauthorized
 
 	// 403 Forbidden
-	case CodeForbidden, CodeUserInactive, CodeSessionNotOwned:
+	case CodeForbidden, CodeUserInactive, CodeSessionNotOwned, CodeDuelNotHost, CodeProgressNotShared:
 		return http.StatusForbidden
 
 	// 404 Not Found
 	case CodeNotFound, CodeUserNotFound, CodeProfileNotFound,
 		CodeSessionNotFound, CodeQuestionNotFound, CodeOptionNotFound,
-		CodeWordNotFound:
+		CodeWordNotFound, CodeDuelRoomNotFound:
 		return http.StatusNotFound
 
 	// 409 Conflict
-	case CodeConflict, CodeEmailExists, CodeUsernameExists:
+	case CodeConflict, CodeEmailExists, CodeUsernameExists, CodeDailyChallengePlayed,
+		CodeDuelRoomFull, CodeDuelAlreadyStarted:
 		return http.StatusConflict
 
 	// 500 Internal Server Error (default)
@@ -85,6 +85,28 @@ func mapvocabgamedomainErrorToAppError(err error) *AppError {
 		return ErrSessionNotOwned
 	case vocabgamedomain.ErrTranslationNotFound:
 		return ErrTranslationNotFound
+	case vocabgamedomain.ErrNoDueReviews:
+		return ErrNoDueReviews
+	case vocabgamedomain.ErrAnswerTimeExpired:
+		return ErrAnswerTimeExpired
+	case vocabgamedomain.ErrSessionNotEnded:
+		return ErrSessionNotEnded
+	case vocabgamedomain.ErrNoMistakesToRetry:
+		return ErrNoMistakesToRetry
+	case vocabgamedomain.ErrDailyChallengePlayed:
+		return ErrDailyChallengePlayed
+	case vocabgamedomain.ErrDuelRoomNotFound:
+		return ErrDuelRoomNotFound
+	case vocabgamedomain.ErrDuelRoomFull:
+		return ErrDuelRoomFull
+	case vocabgamedomain.ErrDuelAlreadyStarted:
+		return ErrDuelAlreadyStarted
+	case vocabgamedomain.ErrDuelNotHost:
+		return ErrDuelNotHost
+	case vocabgamedomain.ErrDuelNotEnoughPlayers:
+		return ErrDuelNotEnoughPlayers
+	case vocabgamedomain.ErrProgressNotShared:
+		return ErrProgressNotShared
 	default:
 		return nil
 	}
@@ -0,0 +1,41 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Event is a message published on a topic.
+// The payload is kept encoded so that buses can carry events across processes.
+type Event struct {
+	Topic      string
+	Type       string
+	Payload    json.RawMessage
+	OccurredAt time.Time
+}
+
+// NewEvent creates an event of a topic, encoding its payload as JSON
+func NewEvent(topic, eventType string, payload interface{}) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{
+		Topic:      topic,
+		Type:       eventType,
+		Payload:    data,
+		OccurredAt: time.Now(),
+	}, nil
+}
+
+// Bus delivers published events to the subscribers of their topic.
+// Implementations may deliver events to subscribers of other processes, e.g. through Postgres LISTEN/NOTIFY,
+// so publishers must not rely on subscribers living in the same process.
+type Bus interface {
+	// Publish delivers an event to the current subscribers of its topic; delivery is at most once
+	Publish(ctx context.Context, event Event) error
+	// Subscribe returns the events published on the topics until ctx is done, then the channel is closed.
+	// Subscribers too slow to keep up miss events rather than block publishers.
+	Subscribe(ctx context.Context, topics ...string) (<-chan Event, error)
+}
@@ -14,8 +14,13 @@ func Success(c *gin.Context, statusCode int, data interface{}) {
 
 // Paginated sends a paginated success response
 func Paginated(c *gin.Context, statusCode int, data interface{}, params *pagination.Params, total int64) {
+	PaginatedWithExtra(c, statusCode, data, params, total, nil)
+}
+
+// PaginatedWithExtra sends a paginated success response with additional top-level fields
+func PaginatedWithExtra(c *gin.Context, statusCode int, data interface{}, params *pagination.Params, total int64, extra gin.H) {
 	metadata := pagination.CalculateMetadata(params, total)
-	c.JSON(statusCode, gin.H{
+	body := gin.H{
 		"data": data,
 		"pagination": gin.H{
 			"page":       metadata.Page,
@@ -27,7 +32,11 @@ func Paginated(c *gin.Context, statusCode int, data interface{}, params *paginat
 			"hasNext":    metadata.HasNext,
 			"hasPrev":    metadata.HasPrev,
 		},
-	})
+	}
+	for key, value := range extra {
+		body[key] = value
+	}
+	c.JSON(statusCode, body)
 }
 
 // ErrorResponse sends an error response
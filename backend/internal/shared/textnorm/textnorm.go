@@ -0,0 +1,52 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize folds text the same way as words.lemma_normalized and words.search_key:
+// lower-case, without diacritics or tone marks, and keeping only letters and digits.
+// "Xin chào", "xin chao" and "xinchao" all normalize to "xinchao"; "nǐ hǎo" to "nihao".
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case r == 'đ':
+			// Vietnamese đ is a separate letter, not d with a combining mark
+			b.WriteRune('d')
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks left by NFD: diacritics and tone marks
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Distance returns the Levenshtein edit distance between a and b, counted in runes
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
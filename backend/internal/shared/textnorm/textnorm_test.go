@@ -0,0 +1,56 @@
+package textnorm
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"only spaces and punctuation", "  !?. ", ""},
+		{"case", "Apple", "apple"},
+		{"Vietnamese diacritics and spaces", "Xin chào", "xinchao"},
+		{"Vietnamese without diacritics", "xin chao", "xinchao"},
+		{"Vietnamese đ", "Đường", "duong"},
+		{"pinyin tone marks", "nǐ hǎo", "nihao"},
+		{"pinyin tone numbers are kept", "xue2xi2", "xue2xi2"},
+		{"punctuation", "e-mail!", "email"},
+		{"han characters", "学习", "学习"},
+		{"precomposed and decomposed forms", "caf\u00e9 cafe\u0301", "cafecafe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.s); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"apple", "apple", 0},
+		{"apple", "aple", 1},
+		{"flaw", "lawn", 2},
+		{"kitten", "sitting", 3},
+		{"学习", "学", 1},
+		{"xinchao", "xinchào", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := Distance(tt.a, tt.b); got != tt.want {
+				t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package uow
+
+import "context"
+
+// UnitOfWork groups repository calls into a single database transaction
+type UnitOfWork interface {
+	// Do runs fn in a transaction. Repositories called with the context passed to fn join the transaction,
+	// which is committed when fn returns nil and rolled back otherwise. Nested calls join the outer transaction.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -3,7 +3,21 @@
  */
 
 import { httpClient } from '@/shared/api/http-client';
-import type { Language, Topic, Level, Word, WordDetail, WordSearchResponse } from '../model/dictionary.types';
+import type {
+  Language,
+  Topic,
+  Level,
+  Word,
+  WordDetail,
+  WordSearchResponse,
+  WordSearchFilters,
+  SearchFacets,
+  ReverseSearchWord,
+  ReverseSearchResponse,
+  WordListEntry,
+  WordListParams,
+  WordListResponse,
+} from '../model/dictionary.types';
 
 export interface ApiResponse<T> {
   success: boolean;
@@ -60,7 +74,8 @@ export const dictionaryEndpoints = {
     query: string,
     languageId: number,
     limit: number = 20,
-    offset: number = 0
+    offset: number = 0,
+    filters: WordSearchFilters = {}
   ): Promise<WordSearchResponse> => {
     const params = new URLSearchParams({
       q: query,
@@ -68,10 +83,98 @@ export const dictionaryEndpoints = {
       limit: limit.toString(),
       offset: offset.toString(),
     });
-    const response = await httpClient.get<PaginatedApiResponse<Word[]>>(
+    if (filters.levelId !== undefined) params.set('levelId', filters.levelId.toString());
+    if (filters.topicIds?.length) params.set('topicIds', filters.topicIds.join(','));
+    if (filters.partOfSpeechId !== undefined) {
+      params.set('partOfSpeechId', filters.partOfSpeechId.toString());
+    }
+    if (filters.hasAudio !== undefined) params.set('hasAudio', filters.hasAudio.toString());
+    if (filters.scriptCode) params.set('scriptCode', filters.scriptCode);
+    const response = await httpClient.get<
+      PaginatedApiResponse<Word[]> & { suggestions?: string[]; facets?: SearchFacets }
+    >(`/dictionary/search?${params.toString()}`);
+    // Transform response to match WordSearchResponse interface
+    return {
+      words: response.data || [],
+      pagination: response.pagination,
+      suggestions: response.suggestions || [],
+      facets: response.facets || { levels: [], topics: [] },
+    };
+  },
+
+  /**
+   * Search words of a language by their meaning: translations into the source language and
+   * definitions of their senses
+   */
+  reverseSearchWords: async (
+    query: string,
+    languageId: number,
+    sourceLanguageId: number,
+    limit: number = 20,
+    offset: number = 0
+  ): Promise<ReverseSearchResponse> => {
+    const params = new URLSearchParams({
+      q: query,
+      languageId: languageId.toString(),
+      mode: 'reverse',
+      sourceLanguageId: sourceLanguageId.toString(),
+      limit: limit.toString(),
+      offset: offset.toString(),
+    });
+    const response = await httpClient.get<PaginatedApiResponse<ReverseSearchWord[]>>(
       `/dictionary/search?${params.toString()}`
     );
-    // Transform response to match WordSearchResponse interface
+    return {
+      words: response.data || [],
+      pagination: response.pagination,
+    };
+  },
+
+  /**
+   * Suggest words starting with what the user typed so far, the most frequent first
+   */
+  autocompleteWords: async (
+    query: string,
+    languageId: number,
+    limit: number = 10
+  ): Promise<Word[]> => {
+    const params = new URLSearchParams({
+      q: query,
+      languageId: languageId.toString(),
+      limit: limit.toString(),
+    });
+    const response = await httpClient.get<ApiResponse<Word[]>>(
+      `/dictionary/suggest?${params.toString()}`
+    );
+    return response.data || [];
+  },
+
+  /**
+   * List the words of a language by level and topics, each with its primary translation
+   */
+  listWords: async ({
+    languageId,
+    levelId,
+    topicIds,
+    sort = 'frequency',
+    targetLanguageId,
+    page = 1,
+    pageSize = 20,
+  }: WordListParams): Promise<WordListResponse> => {
+    const params = new URLSearchParams({
+      languageId: languageId.toString(),
+      sort,
+      page: page.toString(),
+      pageSize: pageSize.toString(),
+    });
+    if (levelId !== undefined) params.set('levelId', levelId.toString());
+    if (topicIds?.length) params.set('topicIds', topicIds.join(','));
+    if (targetLanguageId !== undefined) {
+      params.set('targetLanguageId', targetLanguageId.toString());
+    }
+    const response = await httpClient.get<PaginatedApiResponse<WordListEntry[]>>(
+      `/dictionary/words?${params.toString()}`
+    );
     return {
       words: response.data || [],
       pagination: response.pagination,
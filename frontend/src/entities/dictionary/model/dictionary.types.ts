@@ -38,6 +38,19 @@ export interface Word {
   updated_at: string;
 }
 
+// Word found by its meaning in a reverse search, with the sense that matched
+export interface ReverseSearchWord extends Word {
+  matched_sense: {
+    id: number;
+    sense_order: number;
+    part_of_speech_id: number;
+    definition: string;
+    definition_language_id: number;
+    matched_by: 'translation' | 'definition';
+    matched_text: string; // Lemma of the matching translation, or the definition
+  };
+}
+
 export interface WordRelation {
   relation_type: 'synonym' | 'antonym' | 'related';
   note?: string;
@@ -118,8 +131,61 @@ export interface PaginatedResponse<T> {
   pagination: PaginationMetadata;
 }
 
+export interface WordSearchFilters {
+  levelId?: number;
+  topicIds?: number[];
+  partOfSpeechId?: number;
+  hasAudio?: boolean;
+  scriptCode?: string; // 'Latn', 'Hani', ...
+}
+
+export interface SearchFacet {
+  id: number;
+  code: string;
+  name: string;
+  count: number;
+}
+
+export interface SearchFacets {
+  levels: SearchFacet[];
+  topics: SearchFacet[];
+}
+
 export interface WordSearchResponse {
   words: Word[];
   pagination: PaginationMetadata;
+  suggestions: string[]; // "Did you mean" lemmas, only filled when no word matches
+  facets: SearchFacets; // Results per level and topic, each ignoring its own filter
+}
+
+export type WordListSort = 'frequency' | 'alpha';
+
+export interface WordListParams {
+  languageId: number;
+  levelId?: number;
+  topicIds?: number[];
+  sort?: WordListSort;
+  targetLanguageId?: number; // Language of the primary translations
+  page?: number;
+  pageSize?: number;
+}
+
+export interface WordListEntry extends Word {
+  translation: {
+    id: number;
+    language_id: number;
+    lemma: string;
+    romanization?: string;
+  } | null; // Primary translation into the target language
+}
+
+export interface WordListResponse {
+  words: WordListEntry[];
+  pagination: PaginationMetadata;
+}
+
+export interface ReverseSearchResponse {
+  words: ReverseSearchWord[];
+  pagination: PaginationMetadata;
 }
 
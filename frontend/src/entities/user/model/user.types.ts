@@ -17,6 +17,8 @@ export interface UserProfile {
   avatar_url?: string;
   birth_day?: string; // YYYY-MM-DD format
   bio?: string;
+  timezone?: string; // IANA time zone daily streaks are counted in, UTC when not set
+  share_progress: boolean; // Whether other users may watch the live progress
   created_at: string;
   updated_at: string;
 }
@@ -52,6 +54,8 @@ export interface UpdateProfileRequest {
   avatar_url?: string;
   birth_day?: string; // YYYY-MM-DD format
   bio?: string;
+  timezone?: string; // IANA time zone, e.g. 'Asia/Ho_Chi_Minh'
+  share_progress?: boolean; // Let other users watch the live progress
 }
 
 export interface UpdateProfileResponse {
@@ -60,4 +64,6 @@ export interface UpdateProfileResponse {
   avatar_url?: string;
   birth_day?: string;
   bio?: string;
+  timezone?: string;
+  share_progress: boolean;
 }
@@ -7,16 +7,28 @@ import type {
   VocabGameSession,
   CreateVocabGameSessionRequest,
   VocabGameSessionWithQuestions,
+  VocabGameNextQuestion,
+  VocabGameSessionReview,
   VocabGameAnswer,
   SubmitAnswerRequest,
   SessionStatistics,
+  StartDailyChallengeRequest,
+  DailyChallengeEntry,
+  DailyChallengeLeaderboard,
 } from '../model/vocabgame.types';
+import type { PaginationMetadata } from '@/entities/dictionary/model/dictionary.types';
 
 export interface ApiResponse<T> {
   success: boolean;
   data: T;
 }
 
+export interface PaginatedApiResponse<T> {
+  success: boolean;
+  data: T;
+  pagination: PaginationMetadata;
+}
+
 export const vocabGameEndpoints = {
   /**
    * Create a new vocabgame session
@@ -29,6 +41,55 @@ export const vocabGameEndpoints = {
     return response.data;
   },
 
+  /**
+   * Create a new session asking again the words answered wrongly in a session
+   */
+  retryMistakes: async (sessionId: number): Promise<VocabGameSession> => {
+    const response = await httpClient.post<ApiResponse<VocabGameSession>>(
+      `/vocabgames/sessions/${sessionId}/retry-mistakes`
+    );
+    return response.data;
+  },
+
+  /**
+   * Start today's daily challenge of a language pair, once a day
+   */
+  startDailyChallenge: async (request: StartDailyChallengeRequest): Promise<VocabGameSession> => {
+    const response = await httpClient.post<ApiResponse<VocabGameSession>>(
+      '/vocabgames/daily-challenges',
+      request
+    );
+    return response.data;
+  },
+
+  /**
+   * Get the daily challenge leaderboard of a day (YYYY-MM-DD, default today)
+   */
+  getDailyChallengeLeaderboard: async (
+    sourceLanguageId: number,
+    targetLanguageId: number,
+    date?: string,
+    limit: number = 20,
+    offset: number = 0
+  ): Promise<DailyChallengeLeaderboard> => {
+    const params = new URLSearchParams({
+      source_language_id: sourceLanguageId.toString(),
+      target_language_id: targetLanguageId.toString(),
+      limit: limit.toString(),
+      offset: offset.toString(),
+    });
+    if (date) {
+      params.set('date', date);
+    }
+    const response = await httpClient.get<PaginatedApiResponse<DailyChallengeEntry[]>>(
+      `/vocabgames/daily-challenges/leaderboard?${params.toString()}`
+    );
+    return {
+      entries: response.data || [],
+      pagination: response.pagination,
+    };
+  },
+
   /**
    * Get a vocabgame session with questions
    */
@@ -39,6 +100,26 @@ export const vocabGameEndpoints = {
     return response.data;
   },
 
+  /**
+   * Get the next unanswered question of a session to resume it
+   */
+  getNextQuestion: async (sessionId: number): Promise<VocabGameNextQuestion> => {
+    const response = await httpClient.get<ApiResponse<VocabGameNextQuestion>>(
+      `/vocabgames/sessions/${sessionId}/next`
+    );
+    return response.data;
+  },
+
+  /**
+   * Get the review of an ended session with correct answers and explanations
+   */
+  getSessionReview: async (sessionId: number): Promise<VocabGameSessionReview> => {
+    const response = await httpClient.get<ApiResponse<VocabGameSessionReview>>(
+      `/vocabgames/sessions/${sessionId}/review`
+    );
+    return response.data;
+  },
+
   /**
    * Submit an answer to a question
    */
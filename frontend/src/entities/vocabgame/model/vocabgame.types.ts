@@ -2,26 +2,73 @@
  * VocabGame entity types
  */
 
+import type {
+  Example,
+  PaginationMetadata,
+  Pronunciation,
+} from '@/entities/dictionary/model/dictionary.types';
+
+// 'level': one level, 'topic': themed across levels, 'mixed': every level, 'review': words due for review,
+// 'daily': the daily challenge, only started through the daily challenge endpoint
+export type VocabGameMode = 'level' | 'topic' | 'mixed' | 'review' | 'daily';
+
+// 'word_to_translation' shows the source word; every other type shows prompt_text and asks for the source word
+export type VocabGameQuestionType =
+  | 'word_to_translation'
+  | 'translation_to_word'
+  | 'definition_to_word'
+  | 'example_cloze'
+  | 'pronunciation_to_word'
+  | 'typed_translation'; // Answered by typing the translation, has no options
+
 export interface VocabGameSession {
   id: number;
   user_id: number;
-  mode: 'level'; // Always 'level' now
+  mode: VocabGameMode;
   source_language_id: number;
   target_language_id: number;
   topic_id?: number; // Kept for backward compatibility, but not used for filtering
-  level_id?: number; // Required
+  level_id?: number; // Not set for review sessions
   total_questions: number;
   correct_questions: number;
   started_at: string;
   ended_at?: string;
+  option_count: number;
+  question_time_limit_ms?: number; // Not set when questions have no time limit
+  challenge_date?: string; // Daily challenges only, YYYY-MM-DD
 }
 
 export interface CreateVocabGameSessionRequest {
   source_language_id: number;
   target_language_id: number;
-  mode: 'level'; // Always 'level' now
-  level_id: number; // Required
-  topic_ids?: number[]; // Optional array of topic IDs (empty/null means all topics)
+  mode: VocabGameMode;
+  level_id?: number; // Required for 'level' mode
+  topic_ids?: number[]; // Required for 'topic' mode, optional filter otherwise (empty/null means all topics)
+  question_types?: VocabGameQuestionType[]; // Questions alternate between the given types (default 'word_to_translation')
+  question_count?: number; // 1-20 (default 20)
+  option_count?: number; // 2-6 options per multiple-choice question (default 4)
+  question_time_limit_ms?: number; // 3000-300000, omitted means no time limit
+}
+
+export interface StartDailyChallengeRequest {
+  source_language_id: number;
+  target_language_id: number;
+}
+
+export interface DailyChallengeEntry {
+  rank: number; // Users with the same score and total response time share a rank
+  session_id: number;
+  user_id: number;
+  display_name: string;
+  correct_questions: number;
+  total_questions: number;
+  total_response_time_ms: number;
+  ended_at: string;
+}
+
+export interface DailyChallengeLeaderboard {
+  entries: DailyChallengeEntry[];
+  pagination: PaginationMetadata;
 }
 
 export interface CreateVocabGameSessionResponse {
@@ -33,7 +80,7 @@ export interface VocabGameQuestion {
   id: number;
   session_id: number;
   question_order: number;
-  question_type: string;
+  question_type: VocabGameQuestionType;
   source_word_id: number;
   source_sense_id?: number;
   correct_target_word_id: number;
@@ -45,26 +92,37 @@ export interface VocabGameQuestion {
 export interface VocabGameQuestionOption {
   id: number;
   question_id: number;
-  option_label: 'A' | 'B' | 'C' | 'D';
+  option_label: 'A' | 'B' | 'C' | 'D' | 'E' | 'F';
   target_word_id: number;
   word_text: string; // Word text included in response
   // Note: is_correct is not included in GetSession response for security
   // It's only returned in SubmitAnswer response
 }
 
+export interface VocabGameQuestionHint {
+  part_of_speech?: string;
+  definition?: string; // Omitted for 'definition_to_word' questions
+}
+
 export interface VocabGameQuestionWithOptions {
   id: number;
   session_id: number;
   question_order: number;
-  question_type: string;
+  question_type: VocabGameQuestionType;
   source_word_id: number;
   source_sense_id?: number;
   correct_target_word_id: number;
   source_language_id: number;
   target_language_id: number;
   created_at: string;
-  source_word_text: string; // Source word text included in response
-  options: VocabGameQuestionOption[];
+  prompt_text: string; // Text shown to the learner, depends on question_type
+  source_word_text?: string; // Only set for 'word_to_translation' questions
+  hint?: VocabGameQuestionHint; // Tested sense of the source word
+  options: VocabGameQuestionOption[]; // Empty for 'typed_translation' questions
+  answered: boolean;
+  selected_option_id?: number; // Only set for answered multiple-choice questions
+  answer_text?: string; // Only set for answered 'typed_translation' questions
+  is_correct?: boolean; // Only set for answered questions
 }
 
 export interface VocabGameSessionWithQuestions {
@@ -72,6 +130,60 @@ export interface VocabGameSessionWithQuestions {
   questions: VocabGameQuestionWithOptions[];
 }
 
+export interface VocabGameSessionProgress {
+  answered_questions: number;
+  total_questions: number;
+  correct_answers: number;
+}
+
+export interface VocabGameNextQuestion {
+  session: VocabGameSession;
+  question: VocabGameQuestionWithOptions | null; // null when every question is answered or the session has ended
+  progress: VocabGameSessionProgress;
+  answer_deadline?: string; // Only set for time-limited sessions
+}
+
+export interface VocabGameReviewOption {
+  id: number;
+  option_label: string;
+  target_word_id: number;
+  word_text: string;
+  is_correct: boolean;
+  selected: boolean; // Whether the learner chose this option
+}
+
+export interface VocabGameReviewExplanation {
+  sense_id?: number; // Tested sense, or the first sense of the word
+  part_of_speech?: string;
+  definition?: string;
+  examples: Example[];
+  pronunciations: Pronunciation[];
+}
+
+export interface VocabGameReviewQuestion {
+  id: number;
+  question_order: number;
+  question_type: VocabGameQuestionType;
+  prompt_text: string;
+  source_word_id: number;
+  source_word_text: string;
+  correct_target_word_id: number;
+  correct_word_text: string;
+  options: VocabGameReviewOption[];
+  answered: boolean;
+  is_correct: boolean;
+  selected_option_id?: number;
+  answer_text?: string;
+  response_time_ms?: number;
+  explanation?: VocabGameReviewExplanation; // Only set for missed questions
+}
+
+export interface VocabGameSessionReview {
+  session: VocabGameSession;
+  progress: VocabGameSessionProgress;
+  questions: VocabGameReviewQuestion[];
+}
+
 export interface VocabGameAnswer {
   id: number;
   question_id: number;
@@ -80,12 +192,26 @@ export interface VocabGameAnswer {
   selected_option_id?: number;
   is_correct: boolean;
   response_time_ms?: number;
+  answer_text?: string; // Typed questions only
+  match_result?: 'correct' | 'almost' | 'wrong'; // Typed questions only, 'almost' counts as correct
+  expected_answer?: string; // Typed questions only
   answered_at: string;
+  next_review_at?: string; // When the answered word is next due for review
+  xp_earned: number; // Experience points earned by the answer, 0 for wrong answers
+  new_achievements?: EarnedAchievement[]; // Achievements earned by the answer
+}
+
+export interface EarnedAchievement {
+  code: string;
+  name: string;
+  description: string;
+  earned_at: string;
 }
 
 export interface SubmitAnswerRequest {
   question_id: number;
-  selected_option_id: number;
+  selected_option_id?: number; // Required for multiple-choice questions
+  answer_text?: string; // Required for 'typed_translation' questions
   response_time_ms?: number;
 }
 
@@ -97,5 +223,136 @@ export interface SessionStatistics {
   accuracy_percentage: number; // 0-100
   duration_seconds: number; // Total session duration
   average_response_time_ms: number; // Average response time in milliseconds
+  answered_questions: number;
+  median_response_time_ms: number; // Median response time in milliseconds
+  questions: QuestionStatistics[];
+  missed_words: MissedWord[];
+}
+
+export interface QuestionStatistics {
+  question_id: number;
+  question_order: number;
+  source_word_id: number;
+  source_word_text: string;
+  correct_word_id: number;
+  correct_word_text: string;
+  selected_option_id?: number;
+  selected_word_text?: string;
+  answered: boolean;
+  is_correct: boolean;
+  response_time_ms?: number;
+}
+
+export interface MissedWord {
+  word_id: number;
+  lemma: string;
+  correct_word_id: number;
+  correct_word_text: string;
+  selected_word_text?: string;
+}
+
+
+// Duels are played over the WebSocket at /vocabgames/duels/ws, every message is { type, data }
+
+export interface DuelSettings {
+  source_language_id: number;
+  target_language_id: number;
+  mode: 'level' | 'topic' | 'mixed';
+  level_id?: number; // Required for 'level' mode
+  topic_ids?: number[]; // Required for 'topic' mode
+  question_types?: VocabGameQuestionType[];
+  question_count?: number; // Default 10
+  option_count?: number; // Default 4
+}
+
+export interface DuelPlayer {
+  user_id: number;
+  username: string;
+  points: number; // Questions the player answered correctly first
+  connected: boolean;
+}
+
+export interface DuelRoomState {
+  room_code: string;
+  host_user_id: number;
+  status: 'waiting' | 'starting' | 'playing' | 'ended';
+  settings: DuelSettings;
+  players: DuelPlayer[];
+}
+
+export interface DuelResultPlayer {
+  user_id: number;
+  username: string;
+  session_id: number;
+  points: number;
+  rank: number; // Players with the same points share a rank
+}
+
+export type DuelClientMessage =
+  | { type: 'create_room'; data: DuelSettings }
+  | { type: 'join_room'; data: { room_code: string } }
+  | { type: 'find_match'; data: DuelSettings }
+  | { type: 'start' }
+  | {
+      type: 'answer';
+      data: {
+        question_order: number;
+        selected_option_id?: number; // Required for multiple-choice questions
+        answer_text?: string; // Required for typed questions
+        response_time_ms?: number;
+      };
+    }
+  | { type: 'leave' }
+  | { type: 'ping' };
+
+export type DuelServerMessage =
+  | { type: 'room_state'; data: DuelRoomState }
+  | {
+      // Questions of the session are fetched with getSession(session_id)
+      type: 'duel_started';
+      data: { duel_id: number; session_id: number; total_questions: number; round_timeout_ms: number };
+    }
+  | { type: 'question'; data: { question_order: number; question_id: number; closes_at: string } }
+  | {
+      type: 'answer_result';
+      data: {
+        question_order: number;
+        is_correct: boolean;
+        match_result?: 'correct' | 'almost' | 'wrong';
+        expected_answer?: string;
+        xp_earned: number;
+        won_point: boolean; // Whether the answer was the first correct one of the question
+      };
+    }
+  | { type: 'point'; data: { question_order: number; user_id: number; players: DuelPlayer[] } }
+  | { type: 'duel_ended'; data: { duel_id: number; players: DuelResultPlayer[] } }
+  | { type: 'error'; data: { code: string; message: string; metadata?: Record<string, unknown> } }
+  | { type: 'pong' };
+
+// Live progress is streamed as Server-Sent Events from /vocabgames/progress/events?user_ids=1,2,
+// the event name being the type below and its data the JSON payload
+export interface ProgressAnswerRecordedEvent {
+  session_id: number;
+  user_id: number;
+  question_id: number;
+  question_order: number;
+  is_correct: boolean;
+  correct_questions: number; // Correct answers of the session so far
+  total_questions: number;
+  answered_at: string;
+}
+
+export interface ProgressSessionEndedEvent {
+  session_id: number;
+  user_id: number;
+  mode: VocabGameMode;
+  total_questions: number;
+  answered_questions: number;
+  correct_questions: number;
+  accuracy_percentage: number;
+  ended_at: string;
 }
 
+export type ProgressEvent =
+  | { type: 'answer_recorded'; data: ProgressAnswerRecordedEvent }
+  | { type: 'session_ended'; data: ProgressSessionEndedEvent };
@@ -1,14 +1,26 @@
 /**
  * VocabGame Question Component
- * Displays a question with 4 multiple-choice options (A, B, C, D)
+ * Displays a question with its multiple-choice options (A, B, C, ...)
  */
 
 import { useState, useEffect } from 'react';
-import type { VocabGameQuestionWithOptions } from '@/entities/vocabgame/model/vocabgame.types';
+import type {
+  VocabGameQuestionType,
+  VocabGameQuestionWithOptions,
+} from '@/entities/vocabgame/model/vocabgame.types';
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
 import { Button } from '@/components/ui/button';
 import { cn } from '@/lib/utils';
 
+const QUESTION_INSTRUCTIONS: Record<VocabGameQuestionType, string> = {
+  word_to_translation: 'Chọn từ đúng trong ngôn ngữ đích',
+  translation_to_word: 'Chọn từ gốc có nghĩa này',
+  definition_to_word: 'Chọn từ phù hợp với định nghĩa',
+  example_cloze: 'Chọn từ còn thiếu trong câu',
+  pronunciation_to_word: 'Chọn từ có phát âm này',
+  typed_translation: 'Nhập nghĩa của từ trong ngôn ngữ đích',
+};
+
 interface VocabGameQuestionProps {
   question: VocabGameQuestionWithOptions;
   onAnswerSelect: (optionId: number) => void;
@@ -37,7 +49,7 @@ export default function VocabGameQuestion({
     onAnswerSelect(optionId);
   };
 
-  // Sort options by label (A, B, C, D)
+  // Sort options by label (A, B, C, ...)
   const sortedOptions = [...question.options].sort((a, b) =>
     a.option_label.localeCompare(b.option_label)
   );
@@ -45,9 +57,14 @@ export default function VocabGameQuestion({
   return (
     <Card>
       <CardHeader>
-        <CardTitle className="text-center text-2xl">{question.source_word_text}</CardTitle>
+        <CardTitle className="text-center text-2xl">{question.prompt_text}</CardTitle>
+        {question.hint && (
+          <p className="text-center text-sm italic text-muted-foreground">
+            {[question.hint.part_of_speech, question.hint.definition].filter(Boolean).join(' · ')}
+          </p>
+        )}
         <p className="text-center text-muted-foreground">
-          Chọn từ đúng trong ngôn ngữ đích
+          {QUESTION_INSTRUCTIONS[question.question_type] ?? QUESTION_INSTRUCTIONS.word_to_translation}
         </p>
       </CardHeader>
       <CardContent className="space-y-3">